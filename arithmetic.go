@@ -0,0 +1,110 @@
+package pgvector
+
+import (
+	"math"
+	"slices"
+)
+
+// elementwise applies fn to each pair of elements.
+func elementwise(name string, a []float32, b []float32, fn func(float32, float32) float32) ([]float32, error) {
+	if err := checkDimensions(name, a, b); err != nil {
+		return nil, err
+	}
+
+	vec := make([]float32, len(a))
+	for i := 0; i < len(a); i++ {
+		vec[i] = fn(a[i], b[i])
+	}
+	return vec, nil
+}
+
+func scale(a []float32, s float32) []float32 {
+	vec := make([]float32, len(a))
+	for i := 0; i < len(a); i++ {
+		vec[i] = a[i] * s
+	}
+	return vec
+}
+
+// norm uses double precision like the server.
+func norm(a []float32) float64 {
+	var n float64
+	for _, v := range a {
+		n += float64(v) * float64(v)
+	}
+	return math.Sqrt(n)
+}
+
+// normalize returns a zero vector for a zero norm like the server.
+func normalize(a []float32) []float32 {
+	n := norm(a)
+	vec := make([]float32, len(a))
+	if n > 0 {
+		for i := 0; i < len(a); i++ {
+			vec[i] = float32(float64(a[i]) / n)
+		}
+	}
+	return vec
+}
+
+func add(x float32, y float32) float32 {
+	return x + y
+}
+
+func sub(x float32, y float32) float32 {
+	return x - y
+}
+
+func mul(x float32, y float32) float32 {
+	return x * y
+}
+
+// subvector uses 1-based indexing like the server.
+func subvector(name string, a []float32, start int, count int) ([]float32, error) {
+	if count < 1 {
+		return nil, newError(ErrInvalidDimensions, "%s must have at least 1 dimension", name)
+	}
+
+	// check if start + count > len(a), avoiding integer overflow
+	var end int
+	if start > len(a)-count {
+		end = len(a) + 1
+	} else {
+		end = start + count
+	}
+
+	if start < 1 {
+		start = 1
+	} else if start > len(a) {
+		return nil, newError(ErrInvalidDimensions, "%s must have at least 1 dimension", name)
+	}
+
+	dim := end - start
+	if err := checkDim(name, dim, maxDenseDimensions(name)); err != nil {
+		return nil, err
+	}
+	return slices.Clone(a[start-1 : end-1]), nil
+}
+
+func concat(name string, a []float32, b []float32) ([]float32, error) {
+	if err := checkDim(name, len(a)+len(b), maxDenseDimensions(name)); err != nil {
+		return nil, err
+	}
+	return slices.Concat(a, b), nil
+}
+
+func nonZero(x float32) bool {
+	return x != 0
+}
+
+// filterSparse returns a sparse vector with the elements where keep returns true.
+func filterSparse(dim int32, indices []int32, values []float32, keep func(float32) bool) SparseVector {
+	res := SparseVector{dim: dim, indices: make([]int32, 0, len(indices)), values: make([]float32, 0, len(values))}
+	for i, v := range values {
+		if keep(v) {
+			res.indices = append(res.indices, indices[i])
+			res.values = append(res.values, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,188 @@
+package pgvector
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"slices"
+)
+
+// BitVector is a wrapper for a bit string to implement sql.Scanner and driver.Valuer.
+type BitVector struct {
+	len  int32
+	data []byte
+}
+
+// NewBitVector creates a new BitVector from a slice of bool.
+func NewBitVector(vec []bool) BitVector {
+	data := make([]byte, (len(vec)+7)/8)
+	for i, b := range vec {
+		if b {
+			data[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return BitVector{len: int32(len(vec)), data: data}
+}
+
+// NewBitVectorFromBytes creates a new BitVector from packed bytes (most significant bit first).
+func NewBitVectorFromBytes(data []byte) BitVector {
+	return BitVector{len: int32(len(data) * 8), data: data}
+}
+
+// binaryQuantize sets bits for positive elements like the server.
+func binaryQuantize(vec []float32) BitVector {
+	data := make([]byte, (len(vec)+7)/8)
+	for i, v := range vec {
+		if v > 0 {
+			data[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return BitVector{len: int32(len(vec)), data: data}
+}
+
+// Dimensions returns the number of bits.
+func (v BitVector) Dimensions() int32 {
+	return v.len
+}
+
+// Bytes returns the underlying packed bytes.
+func (v BitVector) Bytes() []byte {
+	return v.data
+}
+
+// Bools returns a slice of bool.
+func (v BitVector) Bools() []bool {
+	vec := make([]bool, v.len)
+	for i := 0; i < len(vec); i++ {
+		vec[i] = v.data[i/8]&(0x80>>(i%8)) != 0
+	}
+	return vec
+}
+
+// Slice returns a slice of float32 with 1 for set bits and 0 otherwise.
+func (v BitVector) Slice() []float32 {
+	vec := make([]float32, v.len)
+	for i := 0; i < len(vec); i++ {
+		if v.data[i/8]&(0x80>>(i%8)) != 0 {
+			vec[i] = 1
+		}
+	}
+	return vec
+}
+
+// Type returns the SQL type name.
+func (v BitVector) Type() string {
+	return "bit"
+}
+
+// OpClass returns the operator class for an index with a distance metric, like bit_hamming_ops.
+func (v BitVector) OpClass(metric Metric) (string, error) {
+	return opClass("bit", metric)
+}
+
+// String returns a string representation of the bit vector.
+func (v BitVector) String() string {
+	buf := make([]byte, v.len)
+	for i := 0; i < len(buf); i++ {
+		if v.data[i/8]&(0x80>>(i%8)) != 0 {
+			buf[i] = '1'
+		} else {
+			buf[i] = '0'
+		}
+	}
+	return string(buf)
+}
+
+// Parse parses a string representation of a bit vector.
+func (v *BitVector) Parse(s string) error {
+	data := make([]byte, (len(s)+7)/8)
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '1':
+			data[i/8] |= 0x80 >> (i % 8)
+		case '0':
+		default:
+			return newError(ErrMalformed, "malformed bit literal")
+		}
+	}
+	v.len = int32(len(s))
+	v.data = data
+	return nil
+}
+
+// EncodeBinary encodes a binary representation of the bit vector.
+func (v BitVector) EncodeBinary(buf []byte) (newBuf []byte, err error) {
+	buf = slices.Grow(buf, 4+len(v.data))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(v.len))
+	buf = append(buf, v.data...)
+	return buf, nil
+}
+
+// DecodeBinary decodes a binary representation of a bit vector.
+func (v *BitVector) DecodeBinary(buf []byte) error {
+	if len(buf) < 4 {
+		return newError(ErrInvalidLength, "invalid length")
+	}
+
+	bitLen := int32(binary.BigEndian.Uint32(buf[0:4]))
+	if bitLen < 0 {
+		return newError(ErrInvalidLength, "bit cannot have negative length")
+	}
+
+	if len(buf)-4 != (int(bitLen)+7)/8 {
+		return newError(ErrInvalidLength, "invalid length")
+	}
+
+	v.len = bitLen
+	v.data = slices.Clone(buf[4:])
+	// clear pad bits like the server
+	if bitLen%8 != 0 {
+		v.data[len(v.data)-1] &= 0xff << (8 - bitLen%8)
+	}
+	return nil
+}
+
+// statically assert that BitVector implements sql.Scanner.
+var _ sql.Scanner = (*BitVector)(nil)
+
+// Scan implements the sql.Scanner interface.
+func (v *BitVector) Scan(src interface{}) (err error) {
+	switch src := src.(type) {
+	case []byte:
+		return v.Parse(string(src))
+	case string:
+		return v.Parse(src)
+	default:
+		return newError(ErrUnsupportedType, "unsupported data type: %T", src)
+	}
+}
+
+// statically assert that BitVector implements driver.Valuer.
+var _ driver.Valuer = (*BitVector)(nil)
+
+// Value implements the driver.Valuer interface.
+func (v BitVector) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// statically assert that BitVector implements json.Marshaler.
+var _ json.Marshaler = (*BitVector)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v BitVector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// statically assert that BitVector implements json.Unmarshaler.
+var _ json.Unmarshaler = (*BitVector)(nil)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *BitVector) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	return v.Parse(s)
+}
@@ -0,0 +1,250 @@
+package pgvector
+
+import (
+	"math"
+	"math/bits"
+)
+
+// floatVector is the set of types supported by the distance functions.
+type floatVector interface {
+	Vector | HalfVector | SparseVector
+}
+
+// L2Distance returns the Euclidean distance between two vectors (the <-> operator).
+func L2Distance[T floatVector](a T, b T) (float64, error) {
+	if x, ok := any(a).(SparseVector); ok {
+		y := any(b).(SparseVector)
+		if err := checkSparseDimensions(x, y); err != nil {
+			return 0, err
+		}
+
+		var distance float32
+		mergeSparse(x, y, func(xv float32, yv float32) {
+			diff := xv - yv
+			distance += diff * diff
+		})
+		return math.Sqrt(float64(distance)), nil
+	}
+
+	var distance float32
+	err := denseElements(a, b, func(xv float32, yv float32) {
+		diff := xv - yv
+		distance += diff * diff
+	})
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(float64(distance)), nil
+}
+
+// InnerProduct returns the inner product of two vectors.
+func InnerProduct[T floatVector](a T, b T) (float64, error) {
+	if x, ok := any(a).(SparseVector); ok {
+		y := any(b).(SparseVector)
+		if err := checkSparseDimensions(x, y); err != nil {
+			return 0, err
+		}
+
+		var dot float32
+		mergeSparse(x, y, func(xv float32, yv float32) {
+			dot += xv * yv
+		})
+		return float64(dot), nil
+	}
+
+	var dot float32
+	err := denseElements(a, b, func(xv float32, yv float32) {
+		dot += xv * yv
+	})
+	if err != nil {
+		return 0, err
+	}
+	return float64(dot), nil
+}
+
+// MaxInnerProduct returns the negative inner product of two vectors (the <#> operator).
+func MaxInnerProduct[T floatVector](a T, b T) (float64, error) {
+	dot, err := InnerProduct(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return -dot, nil
+}
+
+// CosineDistance returns the cosine distance between two vectors (the <=> operator).
+func CosineDistance[T floatVector](a T, b T) (float64, error) {
+	var dot, normA, normB float32
+
+	if x, ok := any(a).(SparseVector); ok {
+		y := any(b).(SparseVector)
+		if err := checkSparseDimensions(x, y); err != nil {
+			return 0, err
+		}
+
+		mergeSparse(x, y, func(xv float32, yv float32) {
+			dot += xv * yv
+		})
+		for _, v := range x.values {
+			normA += v * v
+		}
+		for _, v := range y.values {
+			normB += v * v
+		}
+	} else {
+		err := denseElements(a, b, func(xv float32, yv float32) {
+			dot += xv * yv
+			normA += xv * xv
+			normB += yv * yv
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// use sqrt(a * b) over sqrt(a) * sqrt(b) like the server
+	similarity := float64(dot) / math.Sqrt(float64(normA)*float64(normB))
+
+	// keep in range
+	if similarity > 1 {
+		similarity = 1
+	} else if similarity < -1 {
+		similarity = -1
+	}
+
+	return 1 - similarity, nil
+}
+
+// L1Distance returns the taxicab distance between two vectors (the <+> operator).
+func L1Distance[T floatVector](a T, b T) (float64, error) {
+	if x, ok := any(a).(SparseVector); ok {
+		y := any(b).(SparseVector)
+		if err := checkSparseDimensions(x, y); err != nil {
+			return 0, err
+		}
+
+		var distance float32
+		mergeSparse(x, y, func(xv float32, yv float32) {
+			distance += float32(math.Abs(float64(xv - yv)))
+		})
+		return float64(distance), nil
+	}
+
+	var distance float32
+	err := denseElements(a, b, func(xv float32, yv float32) {
+		distance += float32(math.Abs(float64(xv - yv)))
+	})
+	if err != nil {
+		return 0, err
+	}
+	return float64(distance), nil
+}
+
+// HammingDistance returns the Hamming distance between two bit vectors (the <~> operator).
+func HammingDistance(a BitVector, b BitVector) (float64, error) {
+	if err := checkBitDimensions(a, b); err != nil {
+		return 0, err
+	}
+
+	distance := 0
+	for i := 0; i < len(a.data); i++ {
+		distance += bits.OnesCount8(a.data[i] ^ b.data[i])
+	}
+	return float64(distance), nil
+}
+
+// JaccardDistance returns the Jaccard distance between two bit vectors (the <%> operator).
+func JaccardDistance(a BitVector, b BitVector) (float64, error) {
+	if err := checkBitDimensions(a, b); err != nil {
+		return 0, err
+	}
+
+	ab := 0
+	aa := 0
+	bb := 0
+	for i := 0; i < len(a.data); i++ {
+		ab += bits.OnesCount8(a.data[i] & b.data[i])
+		aa += bits.OnesCount8(a.data[i])
+		bb += bits.OnesCount8(b.data[i])
+	}
+
+	if ab == 0 {
+		return 1, nil
+	}
+	return 1 - (float64(ab) / float64(aa+bb-ab)), nil
+}
+
+// denseElements calls fn for each pair of elements, converting half vectors element by element to avoid allocating.
+func denseElements(a any, b any, fn func(float32, float32)) error {
+	switch a := a.(type) {
+	case Vector:
+		x, y := a.vec, b.(Vector).vec
+		if err := checkDimensions("vector", x, y); err != nil {
+			return err
+		}
+		for i := 0; i < len(x); i++ {
+			fn(x[i], y[i])
+		}
+	case HalfVector:
+		x, y := a.vec, b.(HalfVector).vec
+		if err := checkDimensions("halfvec", x, y); err != nil {
+			return err
+		}
+		for i := 0; i < len(x); i++ {
+			fn(halfToFloat32(x[i]), halfToFloat32(y[i]))
+		}
+	}
+	return nil
+}
+
+func checkDimensions[T float32 | uint16](name string, a []T, b []T) error {
+	if len(a) != len(b) {
+		return newError(ErrDimensionMismatch, "different %s dimensions %d and %d", name, len(a), len(b))
+	}
+	return nil
+}
+
+func checkSparseDimensions(a SparseVector, b SparseVector) error {
+	if a.dim != b.dim {
+		return newError(ErrDimensionMismatch, "different sparsevec dimensions %d and %d", a.dim, b.dim)
+	}
+	return nil
+}
+
+func checkBitDimensions(a BitVector, b BitVector) error {
+	if a.len != b.len {
+		return newError(ErrDimensionMismatch, "different bit lengths %d and %d", a.len, b.len)
+	}
+	return nil
+}
+
+// mergeSparse calls fn for each index that is non-zero in either vector.
+func mergeSparse(a SparseVector, b SparseVector, fn func(float32, float32)) {
+	mergeSparseIndices(a, b, func(_ int32, x float32, y float32) {
+		fn(x, y)
+	})
+}
+
+// mergeSparseIndices is like mergeSparse but also passes the index.
+func mergeSparseIndices(a SparseVector, b SparseVector, fn func(int32, float32, float32)) {
+	i := 0
+	j := 0
+	for i < len(a.indices) && j < len(b.indices) {
+		if a.indices[i] == b.indices[j] {
+			fn(a.indices[i], a.values[i], b.values[j])
+			i++
+			j++
+		} else if a.indices[i] < b.indices[j] {
+			fn(a.indices[i], a.values[i], 0)
+			i++
+		} else {
+			fn(b.indices[j], 0, b.values[j])
+			j++
+		}
+	}
+	for ; i < len(a.indices); i++ {
+		fn(a.indices[i], a.values[i], 0)
+	}
+	for ; j < len(b.indices); j++ {
+		fn(b.indices[j], 0, b.values[j])
+	}
+}
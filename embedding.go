@@ -0,0 +1,56 @@
+package pgvector
+
+// Embedding is implemented by Vector, HalfVector, SparseVector, and BitVector.
+type Embedding interface {
+	// Dimensions returns the number of dimensions.
+	Dimensions() int32
+	// Slice returns a slice of float32.
+	Slice() []float32
+	// String returns a string representation.
+	String() string
+	// Type returns the SQL type name.
+	Type() string
+	// OpClass returns the operator class for an index with a distance metric.
+	OpClass(metric Metric) (string, error)
+}
+
+// statically assert that the types implement Embedding.
+var (
+	_ Embedding = Vector{}
+	_ Embedding = HalfVector{}
+	_ Embedding = SparseVector{}
+	_ Embedding = BitVector{}
+)
+
+// Metric is a distance metric.
+type Metric string
+
+// Distance metrics supported by index operator classes.
+const (
+	// MetricL2 is Euclidean distance (the <-> operator).
+	MetricL2 Metric = "l2"
+	// MetricInnerProduct is negative inner product (the <#> operator).
+	MetricInnerProduct Metric = "ip"
+	// MetricCosine is cosine distance (the <=> operator).
+	MetricCosine Metric = "cosine"
+	// MetricL1 is taxicab distance (the <+> operator).
+	MetricL1 Metric = "l1"
+	// MetricHamming is Hamming distance (the <~> operator).
+	MetricHamming Metric = "hamming"
+	// MetricJaccard is Jaccard distance (the <%> operator).
+	MetricJaccard Metric = "jaccard"
+)
+
+func opClass(typ string, metric Metric) (string, error) {
+	var supported bool
+	switch metric {
+	case MetricL2, MetricInnerProduct, MetricCosine, MetricL1:
+		supported = typ != "bit"
+	case MetricHamming, MetricJaccard:
+		supported = typ == "bit"
+	}
+	if !supported {
+		return "", newError(ErrUnsupportedMetric, "unsupported metric for %s: %s", typ, metric)
+	}
+	return typ + "_" + string(metric) + "_ops", nil
+}
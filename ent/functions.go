@@ -1,41 +1,64 @@
 package ent
 
 import (
+	"strings"
+
 	"entgo.io/ent/dialect/sql"
 )
 
-func L2Distance(column string, value any) sql.Querier {
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(column).WriteString(" <-> ").Arg(value)
-	})
+// Option configures the distance functions.
+type Option func(*options)
+
+type options struct {
+	schema string
 }
 
-func MaxInnerProduct(column string, value any) sql.Querier {
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(column).WriteString(" <#> ").Arg(value)
-	})
+// WithSchema qualifies the operator with the schema the extension is installed in.
+func WithSchema(schema string) Option {
+	return func(o *options) {
+		o.schema = schema
+	}
 }
 
-func CosineDistance(column string, value any) sql.Querier {
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(column).WriteString(" <=> ").Arg(value)
-	})
+func L2Distance(column string, value any, opts ...Option) sql.Querier {
+	return distance(column, "<->", value, opts)
 }
 
-func L1Distance(column string, value any) sql.Querier {
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(column).WriteString(" <+> ").Arg(value)
-	})
+func MaxInnerProduct(column string, value any, opts ...Option) sql.Querier {
+	return distance(column, "<#>", value, opts)
 }
 
-func HammingDistance(column string, value any) sql.Querier {
-	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(column).WriteString(" <~> ").Arg(value)
-	})
+func CosineDistance(column string, value any, opts ...Option) sql.Querier {
+	return distance(column, "<=>", value, opts)
+}
+
+func L1Distance(column string, value any, opts ...Option) sql.Querier {
+	return distance(column, "<+>", value, opts)
 }
 
-func JaccardDistance(column string, value any) sql.Querier {
+func HammingDistance(column string, value any, opts ...Option) sql.Querier {
+	return distance(column, "<~>", value, opts)
+}
+
+func JaccardDistance(column string, value any, opts ...Option) sql.Querier {
+	return distance(column, "<%>", value, opts)
+}
+
+func distance(column string, operator string, value any, opts []Option) sql.Querier {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.schema != "" {
+		operator = "OPERATOR(" + quoteIdent(o.schema) + "." + operator + ")"
+	}
+
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(column).WriteString(" <%> ").Arg(value)
+		b.Ident(column).WriteString(" " + operator + " ").Arg(value)
 	})
 }
+
+func quoteIdent(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
@@ -0,0 +1,101 @@
+package pgvector
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Sentinel errors that can be checked with errors.Is.
+var (
+	// ErrMalformed is returned when a text or JSON representation is malformed.
+	ErrMalformed = errors.New("pgvector: malformed literal")
+	// ErrUnsupportedType is returned when scanning an unsupported data type.
+	ErrUnsupportedType = errors.New("pgvector: unsupported data type")
+	// ErrInvalidLength is returned when a binary representation has an invalid length.
+	ErrInvalidLength = errors.New("pgvector: invalid length")
+	// ErrInvalidDimensions is returned when the number of dimensions is negative or zero.
+	ErrInvalidDimensions = errors.New("pgvector: invalid dimensions")
+	// ErrDimensionMismatch is returned when two values have different dimensions.
+	ErrDimensionMismatch = errors.New("pgvector: dimension mismatch")
+	// ErrTooManyDimensions is returned when the number of dimensions exceeds the limit for the type.
+	ErrTooManyDimensions = errors.New("pgvector: too many dimensions")
+	// ErrTooManyElements is returned when the number of non-zero elements exceeds the limit for sparsevec.
+	ErrTooManyElements = errors.New("pgvector: too many non-zero elements")
+	// ErrIndexOutOfBounds is returned when a sparsevec index is out of bounds.
+	ErrIndexOutOfBounds = errors.New("pgvector: index out of bounds")
+	// ErrUnsortedIndices is returned when sparsevec indices are not in ascending order.
+	ErrUnsortedIndices = errors.New("pgvector: unsorted indices")
+	// ErrDuplicateIndex is returned when sparsevec indices contain duplicates.
+	ErrDuplicateIndex = errors.New("pgvector: duplicate index")
+	// ErrUnknownToken is returned when a token is not in a SparseVocabulary.
+	ErrUnknownToken = errors.New("pgvector: unknown token")
+	// ErrUnsupportedMetric is returned when a distance metric is not supported for a type.
+	ErrUnsupportedMetric = errors.New("pgvector: unsupported metric")
+	// ErrNonFinite is returned when an element is NaN or infinite.
+	ErrNonFinite = errors.New("pgvector: non-finite value")
+	// ErrZeroValue is returned when a sparsevec value is zero.
+	ErrZeroValue = errors.New("pgvector: zero value")
+	// ErrOutOfRange is returned when an element is out of range for the type.
+	ErrOutOfRange = errors.New("pgvector: value out of range")
+)
+
+// kindError keeps its own message while matching a sentinel error.
+type kindError struct {
+	kind error
+	msg  string
+	err  error
+}
+
+func (e *kindError) Error() string {
+	return e.msg
+}
+
+func (e *kindError) Unwrap() []error {
+	if e.err != nil {
+		return []error{e.kind, e.err}
+	}
+	return []error{e.kind}
+}
+
+func newError(kind error, format string, a ...any) error {
+	return &kindError{kind: kind, msg: fmt.Sprintf(format, a...)}
+}
+
+// wrapError keeps the message of err and matches both kind and err.
+func wrapError(kind error, err error) error {
+	return &kindError{kind: kind, msg: err.Error(), err: err}
+}
+
+// wrapNumError wraps an error from the strconv package.
+func wrapNumError(err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return wrapError(ErrOutOfRange, err)
+	}
+	return wrapError(ErrMalformed, err)
+}
+
+// ConversionError is returned when a value cannot be converted to another type without loss.
+type ConversionError struct {
+	// Type is the SQL type being converted to.
+	Type string
+	// Index is the index of the element that cannot be converted, or -1 if not specific to an element.
+	Index int
+	// Reason describes the loss.
+	Reason string
+
+	kind error
+}
+
+// Error implements the error interface.
+func (e *ConversionError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("cannot convert to %s: %s", e.Type, e.Reason)
+	}
+	return fmt.Sprintf("cannot convert element %d to %s: %s", e.Index, e.Type, e.Reason)
+}
+
+// Unwrap returns the sentinel error for the kind of loss.
+func (e *ConversionError) Unwrap() error {
+	return e.kind
+}
@@ -9,7 +9,7 @@ import (
 	"os"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pgvector/pgvector-go"
 )
 
 func main() {
@@ -53,7 +53,7 @@ func main() {
 	}
 
 	for i, content := range input {
-		_, err := conn.Exec(ctx, "INSERT INTO documents (content, embedding) VALUES ($1, $2)", content, PgBit(embeddings[i]))
+		_, err := conn.Exec(ctx, "INSERT INTO documents (content, embedding) VALUES ($1, $2)", content, pgvector.NewBitVectorFromBytes(embeddings[i]))
 		if err != nil {
 			panic(err)
 		}
@@ -65,7 +65,7 @@ func main() {
 		panic(err)
 	}
 
-	rows, err := conn.Query(ctx, "SELECT id, content FROM documents ORDER BY embedding <~> $1 LIMIT 5", PgBit(queryEmbedding[0]))
+	rows, err := conn.Query(ctx, "SELECT id, content FROM documents ORDER BY embedding <~> $1 LIMIT 5", pgvector.NewBitVectorFromBytes(queryEmbedding[0]))
 	if err != nil {
 		panic(err)
 	}
@@ -142,7 +142,3 @@ func Embed(texts []string, inputType string, apiKey string) ([][]byte, error) {
 	}
 	return embeddings, nil
 }
-
-func PgBit(b []byte) pgtype.Bits {
-	return pgtype.Bits{Bytes: b, Len: int32(len(b) * 8), Valid: true}
-}
@@ -0,0 +1,74 @@
+package pgvector
+
+import (
+	"math"
+)
+
+// float32ToHalf converts a float32 to the bits of a float16, rounding to nearest even.
+func float32ToHalf(f float32) uint16 {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int(b>>23) & 0xff
+	mant := b & 0x7fffff
+
+	// infinity or NaN
+	if exp == 0xff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	e := exp - 127 + 15
+
+	// overflow
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	// subnormal or underflow
+	if e <= 0 {
+		if e < -10 {
+			return sign
+		}
+
+		mant |= 0x800000
+		shift := uint(14 - e)
+		h := mant >> shift
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && h&1 != 0) {
+			h++
+		}
+		return sign | uint16(h)
+	}
+
+	// a carry from the mantissa correctly rounds up to the next exponent or infinity
+	h := uint32(e)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && h&1 != 0) {
+		h++
+	}
+	return sign | uint16(h)
+}
+
+// halfToFloat32 converts the bits of a float16 to a float32.
+func halfToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case 0:
+		// zero or subnormal
+		f := float32(mant) / (1 << 24)
+		if sign != 0 {
+			f = -f
+		}
+		return f
+	}
+
+	return math.Float32frombits(sign | (exp+112)<<23 | mant<<13)
+}
@@ -3,25 +3,68 @@ package pgvector
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/binary"
 	"encoding/json"
-	"fmt"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
 )
 
-// HalfVector is a wrapper for []float32 to implement sql.Scanner and driver.Valuer.
+// HalfVector is a wrapper for half-precision floats to implement sql.Scanner and driver.Valuer.
 type HalfVector struct {
-	vec []float32
+	vec []uint16
 }
 
-// NewHalfVector creates a new HalfVector from a slice of float32.
+// NewHalfVector creates a new HalfVector from a slice of float32, rounding to the nearest float16.
 func NewHalfVector(vec []float32) HalfVector {
+	return HalfVector{vec: halfBits(vec)}
+}
+
+// NewHalfVectorFromBits creates a new HalfVector from a slice of float16 bits.
+func NewHalfVectorFromBits(vec []uint16) HalfVector {
 	return HalfVector{vec: vec}
 }
 
-// Slice returns the underlying slice of float32.
+// Slice returns a copy of the elements as float32.
 func (v HalfVector) Slice() []float32 {
+	if v.vec == nil {
+		return nil
+	}
+
+	vec := make([]float32, len(v.vec))
+	for i, h := range v.vec {
+		vec[i] = halfToFloat32(h)
+	}
+	return vec
+}
+
+// SliceInto returns a slice of float32, reusing the capacity of dst.
+func (v HalfVector) SliceInto(dst []float32) []float32 {
+	vec := reuseSlice(dst, len(v.vec))
+	for _, h := range v.vec {
+		vec = append(vec, halfToFloat32(h))
+	}
+	return vec
+}
+
+// Dimensions returns the number of dimensions.
+func (v HalfVector) Dimensions() int32 {
+	return int32(len(v.vec))
+}
+
+// Type returns the SQL type name.
+func (v HalfVector) Type() string {
+	return "halfvec"
+}
+
+// OpClass returns the operator class for an index with a distance metric, like halfvec_l2_ops.
+func (v HalfVector) OpClass(metric Metric) (string, error) {
+	return opClass("halfvec", metric)
+}
+
+// Bits returns the underlying slice of float16 bits.
+func (v HalfVector) Bits() []uint16 {
 	return v.vec
 }
 
@@ -35,25 +78,49 @@ func (v HalfVector) String() string {
 
 // Parse parses a string representation of a half vector.
 func (v *HalfVector) Parse(s string) error {
+	return v.ParseInto(s, nil)
+}
+
+// ParseInto parses a string representation of a half vector, storing the elements in dst if it has enough capacity.
+// Pass v.Bits() to reuse the existing slice.
+func (v *HalfVector) ParseInto(s string, dst []uint16) error {
 	// TODO check brackets in 0.5.0
 	if len(s) < 2 {
-		return fmt.Errorf("malformed halfvec literal")
+		return newError(ErrMalformed, "malformed halfvec literal")
 	}
 
 	if len(s) == 2 {
-		v.vec = []float32{}
+		v.vec = reuseSlice(dst, 0)
 		return nil
 	}
 
-	sp := strings.Split(s[1:len(s)-1], ",")
-	v.vec = make([]float32, 0, len(sp))
-	for i := 0; i < len(sp); i++ {
-		n, err := strconv.ParseFloat(sp[i], 32)
+	s = s[1 : len(s)-1]
+	vec := reuseSlice(dst, strings.Count(s, ",")+1)
+	for {
+		e, rest, found := strings.Cut(s, ",")
+		n, err := strconv.ParseFloat(e, 32)
 		if err != nil {
-			return err
+			v.vec = vec[:0]
+			return wrapNumError(err)
+		}
+		vec = append(vec, float32ToHalf(float32(n)))
+		if !found {
+			break
 		}
-		v.vec = append(v.vec, float32(n))
+		s = rest
+	}
+	v.vec = vec
+	return nil
+}
+
+// ParseStrict parses a string representation of a half vector using the same rules as the server.
+// It returns a *ParseError if the string is malformed.
+func (v *HalfVector) ParseStrict(s string) error {
+	vec, err := parseDenseStrict("halfvec", s)
+	if err != nil {
+		return err
 	}
+	v.vec = halfBits(vec)
 	return nil
 }
 
@@ -65,15 +132,157 @@ func (v HalfVector) EncodeText(buf []byte) (newBuf []byte, err error) {
 		if i > 0 {
 			buf = append(buf, ',')
 		}
-		buf = strconv.AppendFloat(buf, float64(v.vec[i]), 'f', -1, 32)
+		buf = strconv.AppendFloat(buf, float64(halfToFloat32(v.vec[i])), 'f', -1, 32)
 	}
 	buf = append(buf, ']')
 	return buf, nil
 }
 
-// SetSlice sets the underlying slice of float32.
+// Validate returns an error if the half vector would be rejected by the server.
+func (v HalfVector) Validate() error {
+	err := checkDim("halfvec", len(v.vec), MaxHalfVectorDimensions)
+	if err != nil {
+		return err
+	}
+	for _, h := range v.vec {
+		// all exponent bits are set for NaN and infinity
+		if h&0x7c00 == 0x7c00 {
+			if h&0x3ff != 0 {
+				return newError(ErrNonFinite, "NaN not allowed in halfvec")
+			}
+			return newError(ErrNonFinite, "infinite value not allowed in halfvec")
+		}
+	}
+	return nil
+}
+
+// EncodeBinary encodes a binary representation of the half vector.
+func (v HalfVector) EncodeBinary(buf []byte) (newBuf []byte, err error) {
+	dim := len(v.vec)
+	if dim > math.MaxUint16 {
+		return nil, newError(ErrTooManyDimensions, "halfvec cannot have more than %d dimensions", math.MaxUint16)
+	}
+	buf = slices.Grow(buf, 4+2*dim)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(dim))
+	buf = binary.BigEndian.AppendUint16(buf, 0)
+	for _, v := range v.vec {
+		buf = binary.BigEndian.AppendUint16(buf, v)
+	}
+	return buf, nil
+}
+
+// DecodeBinary decodes a binary representation of a half vector.
+func (v *HalfVector) DecodeBinary(buf []byte) error {
+	return v.DecodeBinaryInto(buf, nil)
+}
+
+// DecodeBinaryInto decodes a binary representation of a half vector, storing the elements in dst if it has enough capacity.
+// Pass v.Bits() to reuse the existing slice.
+func (v *HalfVector) DecodeBinaryInto(buf []byte, dst []uint16) error {
+	if len(buf) < 4 {
+		return newError(ErrInvalidLength, "invalid length")
+	}
+
+	dim := int(binary.BigEndian.Uint16(buf[0:2]))
+	if dim < 0 {
+		return newError(ErrInvalidDimensions, "halfvec cannot have negative dimensions")
+	}
+
+	unused := binary.BigEndian.Uint16(buf[2:4])
+	if unused != 0 {
+		return newError(ErrInvalidLength, "expected unused to be 0")
+	}
+
+	if (len(buf)-4)/2 != dim || len(buf)%2 != 0 {
+		return newError(ErrInvalidLength, "invalid length")
+	}
+
+	v.vec = reuseSlice(dst, dim)
+	offset := 4
+	for i := 0; i < dim; i++ {
+		v.vec = append(v.vec, binary.BigEndian.Uint16(buf[offset:offset+2]))
+		offset += 2
+	}
+	return nil
+}
+
+// SetSlice sets the elements from a slice of float32, rounding to the nearest float16.
 func (v *HalfVector) SetSlice(vec []float32) {
-	v.vec = vec
+	v.vec = halfBits(vec)
+}
+
+// Add returns the elementwise sum of two half vectors.
+func (v HalfVector) Add(o HalfVector) (HalfVector, error) {
+	vec, err := elementwise("halfvec", v.Slice(), o.Slice(), add)
+	return HalfVector{vec: halfBits(vec)}, err
+}
+
+// Sub returns the elementwise difference of two half vectors.
+func (v HalfVector) Sub(o HalfVector) (HalfVector, error) {
+	vec, err := elementwise("halfvec", v.Slice(), o.Slice(), sub)
+	return HalfVector{vec: halfBits(vec)}, err
+}
+
+// Mul returns the elementwise product of two half vectors.
+func (v HalfVector) Mul(o HalfVector) (HalfVector, error) {
+	vec, err := elementwise("halfvec", v.Slice(), o.Slice(), mul)
+	return HalfVector{vec: halfBits(vec)}, err
+}
+
+// Scale returns the half vector multiplied by a scalar.
+func (v HalfVector) Scale(s float32) HalfVector {
+	return NewHalfVector(scale(v.Slice(), s))
+}
+
+// Dot returns the inner product of two half vectors.
+func (v HalfVector) Dot(o HalfVector) (float64, error) {
+	return InnerProduct(v, o)
+}
+
+// Norm returns the Euclidean norm of the half vector (like l2_norm).
+func (v HalfVector) Norm() float64 {
+	var n float64
+	for _, h := range v.vec {
+		f := float64(halfToFloat32(h))
+		n += f * f
+	}
+	return math.Sqrt(n)
+}
+
+// Normalize returns the half vector with a Euclidean norm of 1 (like l2_normalize).
+func (v HalfVector) Normalize() HalfVector {
+	return NewHalfVector(normalize(v.Slice()))
+}
+
+// Subvector returns count elements starting at a 1-based index (like subvector).
+func (v HalfVector) Subvector(start int, count int) (HalfVector, error) {
+	vec, err := subvector("halfvec", v.Slice(), start, count)
+	return HalfVector{vec: halfBits(vec)}, err
+}
+
+// Concat returns the concatenation of two half vectors (like the || operator).
+func (v HalfVector) Concat(o HalfVector) (HalfVector, error) {
+	vec, err := concat("halfvec", v.Slice(), o.Slice())
+	return HalfVector{vec: halfBits(vec)}, err
+}
+
+// Truncate returns the first dims elements, normalized (like l2_normalize(subvector(v, 1, dims))).
+func (v HalfVector) Truncate(dims int) (HalfVector, error) {
+	sub, err := v.Subvector(1, dims)
+	if err != nil {
+		return HalfVector{}, err
+	}
+	return sub.Normalize(), nil
+}
+
+// BinaryQuantize returns a bit vector with bits set for positive elements (like binary_quantize).
+func (v HalfVector) BinaryQuantize() BitVector {
+	return binaryQuantize(v.Slice())
+}
+
+// ToVector converts the half vector to a vector.
+func (v HalfVector) ToVector() Vector {
+	return Vector{vec: v.Slice()}
 }
 
 // statically assert that HalfVector implements sql.Scanner.
@@ -87,7 +296,7 @@ func (v *HalfVector) Scan(src interface{}) (err error) {
 	case string:
 		return v.Parse(src)
 	default:
-		return fmt.Errorf("unsupported data type: %T", src)
+		return newError(ErrUnsupportedType, "unsupported data type: %T", src)
 	}
 }
 
@@ -104,7 +313,7 @@ var _ json.Marshaler = (*HalfVector)(nil)
 
 // MarshalJSON implements the json.Marshaler interface.
 func (v HalfVector) MarshalJSON() ([]byte, error) {
-	return json.Marshal(v.vec)
+	return json.Marshal(v.Slice())
 }
 
 // statically assert that HalfVector implements json.Unmarshaler.
@@ -112,5 +321,40 @@ var _ json.Unmarshaler = (*HalfVector)(nil)
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (v *HalfVector) UnmarshalJSON(data []byte) error {
-	return json.Unmarshal(data, &v.vec)
+	var vec []float32
+	err := json.Unmarshal(data, &vec)
+	if err != nil {
+		return err
+	}
+	v.vec = halfBits(vec)
+	return nil
+}
+
+// halfBits rounds each element to the nearest float16.
+func halfBits(vec []float32) []uint16 {
+	res, _ := toHalf(vec, false)
+	return res
+}
+
+// toHalf rounds each element to the nearest float16.
+func toHalf(vec []float32, checked bool) ([]uint16, error) {
+	if vec == nil {
+		return nil, nil
+	}
+
+	res := make([]uint16, len(vec))
+	for i, f := range vec {
+		b := float32ToHalf(f)
+		h := halfToFloat32(b)
+		if checked {
+			if math.IsInf(float64(h), 0) && !math.IsInf(float64(f), 0) {
+				return nil, &ConversionError{Type: "halfvec", Index: i, Reason: "value out of range: overflow", kind: ErrOutOfRange}
+			}
+			if h == 0 && f != 0 {
+				return nil, &ConversionError{Type: "halfvec", Index: i, Reason: "value out of range: underflow", kind: ErrOutOfRange}
+			}
+		}
+		res[i] = b
+	}
+	return res, nil
 }
@@ -0,0 +1,180 @@
+package pgvector
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// NullVector represents a vector that may be null.
+type NullVector struct {
+	Vector Vector
+	Valid  bool // Valid is true if Vector is not NULL
+}
+
+// statically assert that NullVector implements sql.Scanner.
+var _ sql.Scanner = (*NullVector)(nil)
+
+// Scan implements the sql.Scanner interface.
+func (n *NullVector) Scan(src interface{}) error {
+	if src == nil {
+		n.Vector, n.Valid = Vector{}, false
+		return nil
+	}
+	err := n.Vector.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+// statically assert that NullVector implements driver.Valuer.
+var _ driver.Valuer = (*NullVector)(nil)
+
+// Value implements the driver.Valuer interface.
+func (n NullVector) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Vector.Value()
+}
+
+// statically assert that NullVector implements json.Marshaler.
+var _ json.Marshaler = (*NullVector)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullVector) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Vector.MarshalJSON()
+}
+
+// statically assert that NullVector implements json.Unmarshaler.
+var _ json.Unmarshaler = (*NullVector)(nil)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullVector) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		n.Vector, n.Valid = Vector{}, false
+		return nil
+	}
+	err := n.Vector.UnmarshalJSON(data)
+	n.Valid = err == nil
+	return err
+}
+
+// NullHalfVector represents a half vector that may be null.
+type NullHalfVector struct {
+	HalfVector HalfVector
+	Valid      bool // Valid is true if HalfVector is not NULL
+}
+
+// statically assert that NullHalfVector implements sql.Scanner.
+var _ sql.Scanner = (*NullHalfVector)(nil)
+
+// Scan implements the sql.Scanner interface.
+func (n *NullHalfVector) Scan(src interface{}) error {
+	if src == nil {
+		n.HalfVector, n.Valid = HalfVector{}, false
+		return nil
+	}
+	err := n.HalfVector.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+// statically assert that NullHalfVector implements driver.Valuer.
+var _ driver.Valuer = (*NullHalfVector)(nil)
+
+// Value implements the driver.Valuer interface.
+func (n NullHalfVector) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.HalfVector.Value()
+}
+
+// statically assert that NullHalfVector implements json.Marshaler.
+var _ json.Marshaler = (*NullHalfVector)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullHalfVector) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.HalfVector.MarshalJSON()
+}
+
+// statically assert that NullHalfVector implements json.Unmarshaler.
+var _ json.Unmarshaler = (*NullHalfVector)(nil)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullHalfVector) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		n.HalfVector, n.Valid = HalfVector{}, false
+		return nil
+	}
+	err := n.HalfVector.UnmarshalJSON(data)
+	n.Valid = err == nil
+	return err
+}
+
+// NullSparseVector represents a sparse vector that may be null.
+type NullSparseVector struct {
+	SparseVector SparseVector
+	Valid        bool // Valid is true if SparseVector is not NULL
+}
+
+// statically assert that NullSparseVector implements sql.Scanner.
+var _ sql.Scanner = (*NullSparseVector)(nil)
+
+// Scan implements the sql.Scanner interface.
+func (n *NullSparseVector) Scan(src interface{}) error {
+	if src == nil {
+		n.SparseVector, n.Valid = SparseVector{}, false
+		return nil
+	}
+	err := n.SparseVector.Scan(src)
+	n.Valid = err == nil
+	return err
+}
+
+// statically assert that NullSparseVector implements driver.Valuer.
+var _ driver.Valuer = (*NullSparseVector)(nil)
+
+// Value implements the driver.Valuer interface.
+func (n NullSparseVector) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.SparseVector.Value()
+}
+
+// statically assert that NullSparseVector implements json.Marshaler.
+var _ json.Marshaler = (*NullSparseVector)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (n NullSparseVector) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.SparseVector.MarshalJSON()
+}
+
+// statically assert that NullSparseVector implements json.Unmarshaler.
+var _ json.Unmarshaler = (*NullSparseVector)(nil)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *NullSparseVector) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		n.SparseVector, n.Valid = SparseVector{}, false
+		return nil
+	}
+	err := n.SparseVector.UnmarshalJSON(data)
+	n.Valid = err == nil
+	return err
+}
+
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
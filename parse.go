@@ -0,0 +1,322 @@
+package pgvector
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// ParseError is returned by the strict parsers.
+type ParseError struct {
+	// Type is the SQL type being parsed.
+	Type string
+	// Offset is the byte offset in the input.
+	Offset int
+	// Index is the index of the element being parsed, or -1 if not specific to an element.
+	Index int
+	// Msg describes the error.
+	Msg string
+	// Err is the underlying error, if any.
+	Err error
+
+	kind error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("malformed %s literal at offset %d: %s", e.Type, e.Offset, e.Msg)
+	}
+	return fmt.Sprintf("malformed %s literal at offset %d (element %d): %s", e.Type, e.Offset, e.Index, e.Msg)
+}
+
+// Unwrap returns the sentinel error for the kind of error and the underlying error.
+func (e *ParseError) Unwrap() []error {
+	if e.Err != nil {
+		return []error{e.kind, e.Err}
+	}
+	return []error{e.kind}
+}
+
+// parser is a scanner for vector literals that follows the server's rules.
+type parser struct {
+	typ string
+	s   string
+	pos int
+}
+
+func (p *parser) errorf(offset int, index int, kind error, err error, format string, a ...any) error {
+	return &ParseError{Type: p.typ, Offset: offset, Index: index, Msg: fmt.Sprintf(format, a...), Err: err, kind: kind}
+}
+
+// skipSpace skips the same whitespace characters as the server.
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f', ',', ':', '/', '[', ']', '{', '}':
+		return true
+	}
+	return false
+}
+
+func (p *parser) peek() (byte, bool) {
+	if p.pos >= len(p.s) {
+		return 0, false
+	}
+	return p.s[p.pos], true
+}
+
+func (p *parser) expect(c byte, index int) error {
+	p.skipSpace()
+	d, ok := p.peek()
+	if !ok {
+		return p.errorf(p.pos, index, ErrMalformed, nil, "unexpected end of input")
+	}
+	if d != c {
+		return p.errorf(p.pos, index, ErrMalformed, nil, "expected %q, found %q", c, d)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) token(index int) (string, int, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && !isDelimiter(p.s[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == start {
+		c, ok := p.peek()
+		if !ok {
+			return "", start, p.errorf(start, index, ErrMalformed, nil, "unexpected end of input")
+		}
+		return "", start, p.errorf(start, index, ErrMalformed, nil, "unexpected character %q", c)
+	}
+	return p.s[start:p.pos], start, nil
+}
+
+func (p *parser) float(index int) (float32, error) {
+	tok, start, err := p.token(index)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := strconv.ParseFloat(tok, 32)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, p.errorf(start, index, ErrOutOfRange, err, "%q is out of range for type %s", tok, p.typ)
+		}
+		return 0, p.errorf(start, index, ErrMalformed, err, "invalid number %q", tok)
+	}
+
+	if math.IsNaN(n) {
+		return 0, p.errorf(start, index, ErrNonFinite, nil, "NaN not allowed in %s", p.typ)
+	}
+	if math.IsInf(n, 0) {
+		return 0, p.errorf(start, index, ErrNonFinite, nil, "infinite value not allowed in %s", p.typ)
+	}
+
+	f := float32(n)
+	if p.typ == "halfvec" {
+		f = halfToFloat32(float32ToHalf(f))
+		// finite values that overflow halfvec
+		if math.IsInf(float64(f), 0) {
+			return 0, p.errorf(start, index, ErrOutOfRange, nil, "%q is out of range for type %s", tok, p.typ)
+		}
+	}
+	return f, nil
+}
+
+func (p *parser) int(index int) (int32, int, error) {
+	tok, start, err := p.token(index)
+	if err != nil {
+		return 0, start, err
+	}
+
+	n, err := strconv.ParseInt(tok, 10, 32)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, start, p.errorf(start, index, ErrOutOfRange, err, "%q is out of range for type integer", tok)
+		}
+		return 0, start, p.errorf(start, index, ErrMalformed, err, "invalid integer %q", tok)
+	}
+	return int32(n), start, nil
+}
+
+func (p *parser) end() error {
+	p.skipSpace()
+	if p.pos != len(p.s) {
+		return p.errorf(p.pos, -1, ErrMalformed, nil, "junk after end of literal")
+	}
+	return nil
+}
+
+// parseDenseStrict parses a vector or halfvec literal.
+func parseDenseStrict(typ string, s string) ([]float32, error) {
+	p := parser{typ: typ, s: s}
+
+	err := p.expect('[', -1)
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	if c, ok := p.peek(); ok && c == ']' {
+		return nil, p.errorf(p.pos, -1, ErrInvalidDimensions, nil, "%s must have at least 1 dimension", typ)
+	}
+
+	vec := []float32{}
+	maxDim := maxDenseDimensions(typ)
+	for {
+		p.skipSpace()
+		if len(vec) == maxDim {
+			return nil, p.errorf(p.pos, len(vec), ErrTooManyDimensions, nil, "%s cannot have more than %d dimensions", typ, maxDim)
+		}
+
+		f, err := p.float(len(vec))
+		if err != nil {
+			return nil, err
+		}
+		vec = append(vec, f)
+
+		p.skipSpace()
+		c, ok := p.peek()
+		if !ok {
+			return nil, p.errorf(p.pos, -1, ErrMalformed, nil, "unexpected end of input")
+		}
+		p.pos++
+		if c == ']' {
+			break
+		}
+		if c != ',' {
+			return nil, p.errorf(p.pos-1, len(vec)-1, ErrMalformed, nil, "expected \",\" or \"]\", found %q", c)
+		}
+	}
+
+	err = p.end()
+	if err != nil {
+		return nil, err
+	}
+	return vec, nil
+}
+
+// parseSparseStrict parses a sparsevec literal.
+func parseSparseStrict(s string) (SparseVector, error) {
+	p := parser{typ: "sparsevec", s: s}
+
+	err := p.expect('{', -1)
+	if err != nil {
+		return SparseVector{}, err
+	}
+
+	indices := []int32{}
+	values := []float32{}
+	offsets := []int{}
+	elements := []int{}
+
+	p.skipSpace()
+	if c, ok := p.peek(); ok && c == '}' {
+		p.pos++
+	} else {
+		for i := 0; ; i++ {
+			index, offset, err := p.int(i)
+			if err != nil {
+				return SparseVector{}, err
+			}
+
+			err = p.expect(':', i)
+			if err != nil {
+				return SparseVector{}, err
+			}
+
+			value, err := p.float(i)
+			if err != nil {
+				return SparseVector{}, err
+			}
+
+			// like the server, do not store zero values
+			if value != 0 {
+				if len(indices) == MaxSparseVectorNonZero {
+					return SparseVector{}, p.errorf(offset, i, ErrTooManyElements, nil, "sparsevec cannot have more than %d non-zero elements", MaxSparseVectorNonZero)
+				}
+				indices = append(indices, index-1)
+				values = append(values, value)
+				offsets = append(offsets, offset)
+				elements = append(elements, i)
+			}
+
+			p.skipSpace()
+			c, ok := p.peek()
+			if !ok {
+				return SparseVector{}, p.errorf(p.pos, -1, ErrMalformed, nil, "unexpected end of input")
+			}
+			p.pos++
+			if c == '}' {
+				break
+			}
+			if c != ',' {
+				return SparseVector{}, p.errorf(p.pos-1, i, ErrMalformed, nil, "expected \",\" or \"}\", found %q", c)
+			}
+		}
+	}
+
+	err = p.expect('/', -1)
+	if err != nil {
+		return SparseVector{}, err
+	}
+
+	dim, offset, err := p.int(-1)
+	if err != nil {
+		return SparseVector{}, err
+	}
+	if dim < 1 {
+		return SparseVector{}, p.errorf(offset, -1, ErrInvalidDimensions, nil, "sparsevec must have at least 1 dimension")
+	}
+	if dim > MaxSparseVectorDimensions {
+		return SparseVector{}, p.errorf(offset, -1, ErrTooManyDimensions, nil, "sparsevec cannot have more than %d dimensions", MaxSparseVectorDimensions)
+	}
+
+	for i, index := range indices {
+		if index < 0 || index >= dim {
+			return SparseVector{}, p.errorf(offsets[i], elements[i], ErrIndexOutOfBounds, nil, "index out of bounds")
+		}
+	}
+
+	err = p.end()
+	if err != nil {
+		return SparseVector{}, err
+	}
+
+	// like the server, sort elements and reject duplicates
+	order := make([]int, len(indices))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(indices[a], indices[b])
+	})
+
+	vec := SparseVector{dim: dim, indices: make([]int32, len(indices)), values: make([]float32, len(values))}
+	for i, j := range order {
+		if i > 0 && indices[j] == vec.indices[i-1] {
+			return SparseVector{}, p.errorf(offsets[j], elements[j], ErrDuplicateIndex, nil, "indices must not contain duplicates")
+		}
+		vec.indices[i] = indices[j]
+		vec.values[i] = values[j]
+	}
+	return vec, nil
+}
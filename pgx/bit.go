@@ -0,0 +1,105 @@
+package pgx
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pgvector/pgvector-go"
+)
+
+type BitVectorCodec struct{}
+
+func (BitVectorCodec) FormatSupported(format int16) bool {
+	return format == pgx.BinaryFormatCode || format == pgx.TextFormatCode
+}
+
+func (BitVectorCodec) PreferredFormat() int16 {
+	return pgx.BinaryFormatCode
+}
+
+func (BitVectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	_, ok := value.(pgvector.BitVector)
+	if !ok {
+		// fall back to the built-in codec for pgtype.Bits and other values
+		return pgtype.BitsCodec{}.PlanEncode(m, oid, format, value)
+	}
+
+	switch format {
+	case pgx.BinaryFormatCode:
+		return encodePlanBitVectorCodecBinary{}
+	case pgx.TextFormatCode:
+		return encodePlanBitVectorCodecText{}
+	}
+
+	return nil
+}
+
+type encodePlanBitVectorCodecBinary struct{}
+
+func (encodePlanBitVectorCodecBinary) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	v := value.(pgvector.BitVector)
+	return v.EncodeBinary(buf)
+}
+
+type encodePlanBitVectorCodecText struct{}
+
+func (encodePlanBitVectorCodecText) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	v := value.(pgvector.BitVector)
+	return append(buf, v.String()...), nil
+}
+
+func (BitVectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	_, ok := target.(*pgvector.BitVector)
+	if !ok {
+		// fall back to the built-in codec for pgtype.Bits and other targets
+		return pgtype.BitsCodec{}.PlanScan(m, oid, format, target)
+	}
+
+	switch format {
+	case pgx.BinaryFormatCode:
+		return scanPlanBitVectorCodecBinary{}
+	case pgx.TextFormatCode:
+		return scanPlanBitVectorCodecText{}
+	}
+
+	return nil
+}
+
+type scanPlanBitVectorCodecBinary struct{}
+
+func (scanPlanBitVectorCodecBinary) Scan(src []byte, dst any) error {
+	v := (dst).(*pgvector.BitVector)
+	return v.DecodeBinary(src)
+}
+
+type scanPlanBitVectorCodecText struct{}
+
+func (scanPlanBitVectorCodecText) Scan(src []byte, dst any) error {
+	v := (dst).(*pgvector.BitVector)
+	return v.Scan(src)
+}
+
+func (c BitVectorCodec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	return decodeDatabaseSQLValue(c, m, oid, format, src)
+}
+
+func (c BitVectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	var vec pgvector.BitVector
+	scanPlan := c.PlanScan(m, oid, format, &vec)
+	if scanPlan == nil {
+		return nil, fmt.Errorf("Unable to decode bit type: %w", pgvector.ErrUnsupportedType)
+	}
+
+	err := scanPlan.Scan(src, &vec)
+	if err != nil {
+		return nil, err
+	}
+
+	return vec, nil
+}
@@ -2,17 +2,20 @@ package pgx
 
 import (
 	"database/sql/driver"
-	"encoding/binary"
 	"fmt"
-	"slices"
+	"sync"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pgvector/pgvector-go"
-	"github.com/x448/float16"
 )
 
-type HalfVectorCodec struct{}
+type HalfVectorCodec struct {
+	// Validate checks values against server limits before encoding them.
+	Validate bool
+	// ReuseBuffers decodes into the existing slices of scan targets when they have enough capacity.
+	ReuseBuffers bool
+}
 
 func (HalfVectorCodec) FormatSupported(format int16) bool {
 	return format == pgx.BinaryFormatCode || format == pgx.TextFormatCode
@@ -22,17 +25,30 @@ func (HalfVectorCodec) PreferredFormat() int16 {
 	return pgx.BinaryFormatCode
 }
 
-func (HalfVectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
-	_, ok := value.(pgvector.HalfVector)
-	if !ok {
+func (c HalfVectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case pgvector.HalfVector:
+	case pgvector.NullHalfVector:
+		next := c.PlanEncode(m, oid, format, pgvector.HalfVector{})
+		if next == nil {
+			return nil
+		}
+		return encodePlanNullHalfVectorCodec{next: next}
+	case []float32, []float64:
+		next := c.PlanEncode(m, oid, format, pgvector.HalfVector{})
+		if next == nil {
+			return nil
+		}
+		return encodePlanSliceHalfVectorCodec{next: next}
+	default:
 		return nil
 	}
 
 	switch format {
 	case pgx.BinaryFormatCode:
-		return encodePlanHalfVectorCodecBinary{}
+		return validateEncodePlan(encodePlanHalfVectorCodecBinary{}, c.Validate)
 	case pgx.TextFormatCode:
-		return encodePlanHalfVectorCodecText{}
+		return validateEncodePlan(encodePlanHalfVectorCodecText{}, c.Validate)
 	}
 
 	return nil
@@ -42,15 +58,7 @@ type encodePlanHalfVectorCodecBinary struct{}
 
 func (encodePlanHalfVectorCodecBinary) Encode(value any, buf []byte) (newBuf []byte, err error) {
 	v := value.(pgvector.HalfVector)
-	vec := v.Slice()
-	dim := len(vec)
-	buf = slices.Grow(buf, 4+2*dim)
-	buf = binary.BigEndian.AppendUint16(buf, uint16(dim))
-	buf = binary.BigEndian.AppendUint16(buf, 0)
-	for _, v := range vec {
-		buf = binary.BigEndian.AppendUint16(buf, float16.Fromfloat32(v).Bits())
-	}
-	return buf, nil
+	return v.EncodeBinary(buf)
 }
 
 type encodePlanHalfVectorCodecText struct{}
@@ -60,65 +68,163 @@ func (encodePlanHalfVectorCodecText) Encode(value any, buf []byte) (newBuf []byt
 	return v.EncodeText(buf)
 }
 
-func (HalfVectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
-	_, ok := target.(*pgvector.HalfVector)
-	if !ok {
+func (c HalfVectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *pgvector.HalfVector:
+	case *pgvector.NullHalfVector:
+		next := c.PlanScan(m, oid, format, &pgvector.HalfVector{})
+		if next == nil {
+			return nil
+		}
+		return scanPlanNullHalfVectorCodec{next: next}
+	case *[]float32, *[]float64:
+		next := c.PlanScan(m, oid, format, &pgvector.HalfVector{})
+		if next == nil {
+			return nil
+		}
+		return scanPlanSliceHalfVectorCodec{next: next, reuse: c.ReuseBuffers}
+	default:
 		return nil
 	}
 
 	switch format {
 	case pgx.BinaryFormatCode:
-		return scanPlanHalfVectorCodecBinary{}
+		return scanPlanHalfVectorCodecBinary{reuse: c.ReuseBuffers}
 	case pgx.TextFormatCode:
-		return scanPlanHalfVectorCodecText{}
+		return scanPlanHalfVectorCodecText{reuse: c.ReuseBuffers}
 	}
 
 	return nil
 }
 
-type scanPlanHalfVectorCodecBinary struct{}
+type scanPlanHalfVectorCodecBinary struct {
+	reuse bool
+}
 
-func (scanPlanHalfVectorCodecBinary) Scan(src []byte, dst any) error {
+func (p scanPlanHalfVectorCodecBinary) Scan(src []byte, dst any) error {
 	v := (dst).(*pgvector.HalfVector)
-	buf := src
-
-	if len(buf) < 4 {
-		return fmt.Errorf("invalid length")
+	if p.reuse {
+		return v.DecodeBinaryInto(src, v.Bits())
 	}
+	return v.DecodeBinary(src)
+}
 
-	dim := int(binary.BigEndian.Uint16(buf[0:2]))
-	if dim < 0 {
-		return fmt.Errorf("halfvec cannot have negative dimensions")
+type scanPlanHalfVectorCodecText struct {
+	reuse bool
+}
+
+func (p scanPlanHalfVectorCodecText) Scan(src []byte, dst any) error {
+	v := (dst).(*pgvector.HalfVector)
+	if p.reuse {
+		return v.ParseInto(unsafeString(src), v.Bits())
 	}
+	return v.Scan(src)
+}
 
-	unused := binary.BigEndian.Uint16(buf[2:4])
-	if unused != 0 {
-		return fmt.Errorf("expected unused to be 0")
+type encodePlanNullHalfVectorCodec struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlanNullHalfVectorCodec) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	v := value.(pgvector.NullHalfVector)
+	if !v.Valid {
+		return nil, nil
 	}
+	return p.next.Encode(v.HalfVector, buf)
+}
 
-	if (len(buf)-4)/2 != dim || len(buf)%2 != 0 {
-		return fmt.Errorf("invalid length")
+type scanPlanNullHalfVectorCodec struct {
+	next pgtype.ScanPlan
+}
+
+func (p scanPlanNullHalfVectorCodec) Scan(src []byte, dst any) error {
+	v := (dst).(*pgvector.NullHalfVector)
+	if src == nil {
+		*v = pgvector.NullHalfVector{}
+		return nil
 	}
+	err := p.next.Scan(src, &v.HalfVector)
+	v.Valid = err == nil
+	return err
+}
 
-	vec := make([]float32, 0, dim)
-	offset := 4
-	for i := 0; i < dim; i++ {
-		vec = append(vec, float16.Frombits(binary.BigEndian.Uint16(buf[offset:offset+2])).Float32())
-		offset += 2
+type encodePlanSliceHalfVectorCodec struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlanSliceHalfVectorCodec) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	switch v := value.(type) {
+	case []float32:
+		if v == nil {
+			return nil, nil
+		}
+		return p.next.Encode(pgvector.NewHalfVector(v), buf)
+	case []float64:
+		if v == nil {
+			return nil, nil
+		}
+		return p.next.Encode(pgvector.NewHalfVector(toFloat32s(v)), buf)
 	}
-	v.SetSlice(vec)
-	return nil
+	return nil, fmt.Errorf("unsupported data type: %T", value)
 }
 
-type scanPlanHalfVectorCodecText struct{}
+type scanPlanSliceHalfVectorCodec struct {
+	next  pgtype.ScanPlan
+	reuse bool
+}
 
-func (scanPlanHalfVectorCodecText) Scan(src []byte, dst any) error {
-	v := (dst).(*pgvector.HalfVector)
-	return v.Scan(src)
+// halfVectorScratch holds the intermediate values for scanning into slices when reusing buffers.
+type halfVectorScratch struct {
+	vec    pgvector.HalfVector
+	floats []float32
+}
+
+var halfVectorPool = sync.Pool{New: func() any { return new(halfVectorScratch) }}
+
+func (p scanPlanSliceHalfVectorCodec) Scan(src []byte, dst any) error {
+	if src == nil {
+		switch dst := dst.(type) {
+		case *[]float32:
+			*dst = nil
+		case *[]float64:
+			*dst = nil
+		}
+		return nil
+	}
+
+	var scratch *halfVectorScratch
+	if p.reuse {
+		scratch = halfVectorPool.Get().(*halfVectorScratch)
+		defer halfVectorPool.Put(scratch)
+	} else {
+		scratch = &halfVectorScratch{}
+	}
+	v := &scratch.vec
+	err := p.next.Scan(src, v)
+	if err != nil {
+		return err
+	}
+
+	switch dst := dst.(type) {
+	case *[]float32:
+		if p.reuse {
+			*dst = v.SliceInto(*dst)
+		} else {
+			*dst = v.Slice()
+		}
+	case *[]float64:
+		if p.reuse {
+			scratch.floats = v.SliceInto(scratch.floats)
+			*dst = toFloat64s(scratch.floats, *dst)
+		} else {
+			*dst = toFloat64s(v.Slice(), nil)
+		}
+	}
+	return nil
 }
 
 func (c HalfVectorCodec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
-	return c.DecodeValue(m, oid, format, src)
+	return decodeDatabaseSQLValue(c, m, oid, format, src)
 }
 
 func (c HalfVectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
@@ -129,7 +235,7 @@ func (c HalfVectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, sr
 	var vec pgvector.HalfVector
 	scanPlan := c.PlanScan(m, oid, format, &vec)
 	if scanPlan == nil {
-		return nil, fmt.Errorf("Unable to decode halfvec type")
+		return nil, fmt.Errorf("Unable to decode halfvec type: %w", pgvector.ErrUnsupportedType)
 	}
 
 	err := scanPlan.Scan(src, &vec)
@@ -0,0 +1,74 @@
+package pgx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AfterConnect returns a function for pgxpool.Config.AfterConnect that registers the types on each new connection.
+// OIDs are looked up on the first connection to each database and cached for later connections.
+// Recreate the pool if the extension is dropped and recreated.
+func AfterConnect(opts ...Option) func(context.Context, *pgx.Conn) error {
+	o := newOptions(opts)
+	cache := &typeOidCache{oids: make(map[string]typeOids)}
+
+	return func(ctx context.Context, conn *pgx.Conn) error {
+		oids, err := cache.load(ctx, conn, o)
+		if err != nil {
+			return err
+		}
+
+		registerTypeOids(conn.TypeMap(), oids, o)
+		return nil
+	}
+}
+
+// RegisterTypesPool configures a pool to register the types on each new connection.
+// It runs before any existing AfterConnect function.
+func RegisterTypesPool(config *pgxpool.Config, opts ...Option) {
+	afterConnect := AfterConnect(opts...)
+	next := config.AfterConnect
+	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+		err := afterConnect(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			return next(ctx, conn)
+		}
+		return nil
+	}
+}
+
+// typeOidCache caches OIDs per database.
+type typeOidCache struct {
+	mu   sync.Mutex
+	oids map[string]typeOids
+}
+
+func (c *typeOidCache) load(ctx context.Context, conn *pgx.Conn, o options) (typeOids, error) {
+	config := conn.Config()
+	key := fmt.Sprintf("%s:%d/%s", config.Host, config.Port, config.Database)
+
+	c.mu.Lock()
+	oids, ok := c.oids[key]
+	c.mu.Unlock()
+	if ok {
+		return oids, nil
+	}
+
+	// connections may look up OIDs concurrently, which is fine since the results are the same
+	oids, err := loadTypeOids(ctx, conn, o)
+	if err != nil {
+		return oids, err
+	}
+
+	c.mu.Lock()
+	c.oids[key] = oids
+	c.mu.Unlock()
+	return oids, nil
+}
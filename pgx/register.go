@@ -2,48 +2,241 @@ package pgx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-func RegisterTypes(ctx context.Context, conn *pgx.Conn) error {
-	var vectorOid *uint32
-	var vectorArrayOid *uint32
-	var halfvecOid *uint32
-	var halfvecArrayOid *uint32
-	var sparsevecOid *uint32
-	var sparsevecArrayOid *uint32
-	err := conn.QueryRow(ctx, "SELECT to_regtype('vector')::oid, to_regtype('_vector')::oid, to_regtype('halfvec')::oid, to_regtype('_halfvec')::oid, to_regtype('sparsevec')::oid, to_regtype('_sparsevec')::oid").Scan(&vectorOid, &vectorArrayOid, &halfvecOid, &halfvecArrayOid, &sparsevecOid, &sparsevecArrayOid)
+// Option configures RegisterTypes.
+type Option func(*options)
+
+type options struct {
+	validate       bool
+	reuseBuffers   bool
+	schema         string
+	discoverSchema bool
+	domains        bool
+	bitVector      bool
+}
+
+// WithSchema looks up the types in the schema the extension is installed in.
+func WithSchema(schema string) Option {
+	return func(o *options) {
+		o.schema = schema
+	}
+}
+
+// WithSchemaDiscovery looks up the schema the extension is installed in from pg_extension.
+// Use this when the schema is not on the search_path.
+func WithSchemaDiscovery() Option {
+	return func(o *options) {
+		o.discoverSchema = true
+	}
+}
+
+// WithValidation validates vectors against server limits before encoding them.
+func WithValidation() Option {
+	return func(o *options) {
+		o.validate = true
+	}
+}
+
+// WithBufferReuse decodes vectors into the existing slices of scan targets when they have enough capacity.
+// Copy a value before scanning into it again if it needs to be kept.
+func WithBufferReuse() Option {
+	return func(o *options) {
+		o.reuseBuffers = true
+	}
+}
+
+// WithDomains also registers domains over vector, halfvec, and sparsevec, along with their array types.
+// Domains over bit and varbit are also registered with WithBitVector.
+func WithDomains() Option {
+	return func(o *options) {
+		o.domains = true
+	}
+}
+
+// WithBitVector registers BitVectorCodec for bit and varbit, replacing the built-in codec.
+// Values of these types are then decoded as pgvector.BitVector instead of pgtype.Bits.
+func WithBitVector() Option {
+	return func(o *options) {
+		o.bitVector = true
+	}
+}
+
+func RegisterTypes(ctx context.Context, conn *pgx.Conn, opts ...Option) error {
+	o := newOptions(opts)
+	oids, err := loadTypeOids(ctx, conn, o)
 	if err != nil {
 		return err
 	}
 
-	if vectorOid == nil {
-		return fmt.Errorf("vector type not found in the database")
+	registerTypeOids(conn.TypeMap(), oids, o)
+	return nil
+}
+
+func newOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
 	}
+	return o
+}
+
+// typeOids are the OIDs of the extension types in a database.
+type typeOids struct {
+	schema         string
+	vector         *uint32
+	vectorArray    *uint32
+	halfvec        *uint32
+	halfvecArray   *uint32
+	sparsevec      *uint32
+	sparsevecArray *uint32
+	domains        []domainOids
+}
+
+// domainOids are the OIDs of a domain over one of the types.
+type domainOids struct {
+	schema   string
+	name     string
+	oid      uint32
+	arrayOid *uint32
+	baseOid  uint32
+}
 
-	tm := conn.TypeMap()
-	registerType(tm, "vector", vectorOid, vectorArrayOid, &VectorCodec{})
+func loadTypeOids(ctx context.Context, conn *pgx.Conn, o options) (typeOids, error) {
+	var oids typeOids
 
-	if halfvecOid != nil {
-		registerType(tm, "halfvec", halfvecOid, halfvecArrayOid, &HalfVectorCodec{})
+	schema := o.schema
+	if schema == "" && o.discoverSchema {
+		err := conn.QueryRow(ctx, "SELECT n.nspname FROM pg_extension e INNER JOIN pg_namespace n ON n.oid = e.extnamespace WHERE e.extname = 'vector'").Scan(&schema)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return oids, fmt.Errorf("vector extension not found in the database")
+		}
+		if err != nil {
+			return oids, err
+		}
 	}
+	oids.schema = schema
 
-	if sparsevecOid != nil {
-		registerType(tm, "sparsevec", sparsevecOid, sparsevecArrayOid, &SparseVectorCodec{})
+	names := make([]any, 0, 6)
+	for _, name := range []string{"vector", "_vector", "halfvec", "_halfvec", "sparsevec", "_sparsevec"} {
+		if schema != "" {
+			name = pgx.Identifier{schema, name}.Sanitize()
+		}
+		names = append(names, name)
 	}
 
-	return nil
+	err := conn.QueryRow(ctx, "SELECT to_regtype($1)::oid, to_regtype($2)::oid, to_regtype($3)::oid, to_regtype($4)::oid, to_regtype($5)::oid, to_regtype($6)::oid", names...).Scan(&oids.vector, &oids.vectorArray, &oids.halfvec, &oids.halfvecArray, &oids.sparsevec, &oids.sparsevecArray)
+	if err != nil {
+		return oids, err
+	}
+
+	if oids.vector == nil {
+		if schema != "" {
+			return oids, fmt.Errorf("vector type not found in schema %s", schema)
+		}
+		return oids, fmt.Errorf("vector type not found in the database")
+	}
+
+	if o.domains {
+		oids.domains, err = loadDomainOids(ctx, conn, oids, o)
+		if err != nil {
+			return oids, err
+		}
+	}
+
+	return oids, nil
+}
+
+func loadDomainOids(ctx context.Context, conn *pgx.Conn, oids typeOids, o options) ([]domainOids, error) {
+	baseOids := []uint32{*oids.vector}
+	if oids.halfvec != nil {
+		baseOids = append(baseOids, *oids.halfvec)
+	}
+	if oids.sparsevec != nil {
+		baseOids = append(baseOids, *oids.sparsevec)
+	}
+	if o.bitVector {
+		baseOids = append(baseOids, pgtype.BitOID, pgtype.VarbitOID)
+	}
+
+	// include domains over other domains
+	rows, err := conn.Query(ctx, `WITH RECURSIVE domains (oid, base) AS (
+	SELECT oid, typbasetype FROM pg_type WHERE typtype = 'd' AND typbasetype = ANY($1)
+	UNION ALL
+	SELECT t.oid, d.base FROM pg_type t INNER JOIN domains d ON t.typbasetype = d.oid WHERE t.typtype = 'd'
+)
+SELECT n.nspname, t.typname, t.oid, NULLIF(t.typarray, 0), d.base FROM domains d INNER JOIN pg_type t ON t.oid = d.oid INNER JOIN pg_namespace n ON n.oid = t.typnamespace`, baseOids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var domains []domainOids
+	for rows.Next() {
+		var d domainOids
+		err = rows.Scan(&d.schema, &d.name, &d.oid, &d.arrayOid, &d.baseOid)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, d)
+	}
+	return domains, rows.Err()
 }
 
-func registerType(tm *pgtype.Map, name string, oid *uint32, arrayOid *uint32, codec pgtype.Codec) {
+func registerTypeOids(tm *pgtype.Map, oids typeOids, o options) {
+	codecs := make(map[uint32]pgtype.Codec)
+
+	vectorCodec := &VectorCodec{Validate: o.validate, ReuseBuffers: o.reuseBuffers}
+	registerType(tm, oids.schema, "vector", oids.vector, oids.vectorArray, vectorCodec)
+	codecs[*oids.vector] = vectorCodec
+
+	if oids.halfvec != nil {
+		halfvecCodec := &HalfVectorCodec{Validate: o.validate, ReuseBuffers: o.reuseBuffers}
+		registerType(tm, oids.schema, "halfvec", oids.halfvec, oids.halfvecArray, halfvecCodec)
+		codecs[*oids.halfvec] = halfvecCodec
+	}
+
+	if oids.sparsevec != nil {
+		sparsevecCodec := &SparseVectorCodec{Validate: o.validate, ReuseBuffers: o.reuseBuffers}
+		registerType(tm, oids.schema, "sparsevec", oids.sparsevec, oids.sparsevecArray, sparsevecCodec)
+		codecs[*oids.sparsevec] = sparsevecCodec
+	}
+
+	if o.bitVector {
+		// bit and varbit are built-in types with fixed OIDs
+		bitCodec := &BitVectorCodec{}
+		registerBuiltinType(tm, "bit", pgtype.BitOID, pgtype.BitArrayOID, bitCodec)
+		registerBuiltinType(tm, "varbit", pgtype.VarbitOID, pgtype.VarbitArrayOID, bitCodec)
+		codecs[pgtype.BitOID] = bitCodec
+		codecs[pgtype.VarbitOID] = bitCodec
+	}
+
+	for _, d := range oids.domains {
+		registerType(tm, d.schema, d.name, &d.oid, d.arrayOid, codecs[d.baseOid])
+	}
+}
+
+func registerType(tm *pgtype.Map, schema string, name string, oid *uint32, arrayOid *uint32, codec pgtype.Codec) {
+	arrayName := "_" + name
+	if schema != "" {
+		name = schema + "." + name
+		arrayName = schema + "." + arrayName
+	}
+
 	t := pgtype.Type{Name: name, OID: *oid, Codec: codec}
 	tm.RegisterType(&t)
 
-	// should never be nil
+	// should never be nil for extension types
 	if arrayOid != nil {
-		tm.RegisterType(&pgtype.Type{Name: "_" + name, OID: *arrayOid, Codec: &pgtype.ArrayCodec{ElementType: &t}})
+		tm.RegisterType(&pgtype.Type{Name: arrayName, OID: *arrayOid, Codec: &pgtype.ArrayCodec{ElementType: &t}})
 	}
 }
+
+func registerBuiltinType(tm *pgtype.Map, name string, oid uint32, arrayOid uint32, codec pgtype.Codec) {
+	registerType(tm, "", name, &oid, &arrayOid, codec)
+}
@@ -9,7 +9,12 @@ import (
 	"github.com/pgvector/pgvector-go"
 )
 
-type SparseVectorCodec struct{}
+type SparseVectorCodec struct {
+	// Validate checks values against server limits before encoding them.
+	Validate bool
+	// ReuseBuffers decodes into the existing slices of scan targets when they have enough capacity.
+	ReuseBuffers bool
+}
 
 func (SparseVectorCodec) FormatSupported(format int16) bool {
 	return format == pgx.BinaryFormatCode || format == pgx.TextFormatCode
@@ -19,17 +24,24 @@ func (SparseVectorCodec) PreferredFormat() int16 {
 	return pgx.BinaryFormatCode
 }
 
-func (SparseVectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
-	_, ok := value.(pgvector.SparseVector)
-	if !ok {
+func (c SparseVectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case pgvector.SparseVector:
+	case pgvector.NullSparseVector:
+		next := c.PlanEncode(m, oid, format, pgvector.SparseVector{})
+		if next == nil {
+			return nil
+		}
+		return encodePlanNullSparseVectorCodec{next: next}
+	default:
 		return nil
 	}
 
 	switch format {
 	case pgx.BinaryFormatCode:
-		return encodePlanSparseVectorCodecBinary{}
+		return validateEncodePlan(encodePlanSparseVectorCodecBinary{}, c.Validate)
 	case pgx.TextFormatCode:
-		return encodePlanSparseVectorCodecText{}
+		return validateEncodePlan(encodePlanSparseVectorCodecText{}, c.Validate)
 	}
 
 	return nil
@@ -50,38 +62,114 @@ func (encodePlanSparseVectorCodecText) Encode(value any, buf []byte) (newBuf []b
 	return append(buf, v.String()...), nil
 }
 
-func (SparseVectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
-	_, ok := target.(*pgvector.SparseVector)
-	if !ok {
+func (c SparseVectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *pgvector.SparseVector:
+	case *pgvector.NullSparseVector:
+		next := c.PlanScan(m, oid, format, &pgvector.SparseVector{})
+		if next == nil {
+			return nil
+		}
+		return scanPlanNullSparseVectorCodec{next: next}
+	case *map[int32]float32:
+		next := c.PlanScan(m, oid, format, &pgvector.SparseVector{})
+		if next == nil {
+			return nil
+		}
+		return scanPlanMapSparseVectorCodec{next: next}
+	default:
 		return nil
 	}
 
 	switch format {
 	case pgx.BinaryFormatCode:
-		return scanPlanSparseVectorCodecBinary{}
+		return scanPlanSparseVectorCodecBinary{reuse: c.ReuseBuffers}
 	case pgx.TextFormatCode:
-		return scanPlanSparseVectorCodecText{}
+		return scanPlanSparseVectorCodecText{reuse: c.ReuseBuffers}
 	}
 
 	return nil
 }
 
-type scanPlanSparseVectorCodecBinary struct{}
+type scanPlanSparseVectorCodecBinary struct {
+	reuse bool
+}
 
-func (scanPlanSparseVectorCodecBinary) Scan(src []byte, dst any) error {
+func (p scanPlanSparseVectorCodecBinary) Scan(src []byte, dst any) error {
 	v := (dst).(*pgvector.SparseVector)
+	if p.reuse {
+		return v.DecodeBinaryInto(src, v.Indices(), v.Values())
+	}
 	return v.DecodeBinary(src)
 }
 
-type scanPlanSparseVectorCodecText struct{}
+type scanPlanSparseVectorCodecText struct {
+	reuse bool
+}
 
-func (scanPlanSparseVectorCodecText) Scan(src []byte, dst any) error {
+func (p scanPlanSparseVectorCodecText) Scan(src []byte, dst any) error {
 	v := (dst).(*pgvector.SparseVector)
+	if p.reuse {
+		return v.ParseInto(unsafeString(src), v.Indices(), v.Values())
+	}
 	return v.Scan(src)
 }
 
+type encodePlanNullSparseVectorCodec struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlanNullSparseVectorCodec) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	v := value.(pgvector.NullSparseVector)
+	if !v.Valid {
+		return nil, nil
+	}
+	return p.next.Encode(v.SparseVector, buf)
+}
+
+type scanPlanNullSparseVectorCodec struct {
+	next pgtype.ScanPlan
+}
+
+func (p scanPlanNullSparseVectorCodec) Scan(src []byte, dst any) error {
+	v := (dst).(*pgvector.NullSparseVector)
+	if src == nil {
+		*v = pgvector.NullSparseVector{}
+		return nil
+	}
+	err := p.next.Scan(src, &v.SparseVector)
+	v.Valid = err == nil
+	return err
+}
+
+type scanPlanMapSparseVectorCodec struct {
+	next pgtype.ScanPlan
+}
+
+func (p scanPlanMapSparseVectorCodec) Scan(src []byte, dst any) error {
+	m := (dst).(*map[int32]float32)
+	if src == nil {
+		*m = nil
+		return nil
+	}
+
+	var v pgvector.SparseVector
+	err := p.next.Scan(src, &v)
+	if err != nil {
+		return err
+	}
+
+	values := v.Values()
+	elements := make(map[int32]float32, len(values))
+	for i, index := range v.Indices() {
+		elements[index] = values[i]
+	}
+	*m = elements
+	return nil
+}
+
 func (c SparseVectorCodec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
-	return c.DecodeValue(m, oid, format, src)
+	return decodeDatabaseSQLValue(c, m, oid, format, src)
 }
 
 func (c SparseVectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
@@ -92,7 +180,7 @@ func (c SparseVectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16,
 	var vec pgvector.SparseVector
 	scanPlan := c.PlanScan(m, oid, format, &vec)
 	if scanPlan == nil {
-		return nil, fmt.Errorf("Unable to decode sparsevec type")
+		return nil, fmt.Errorf("Unable to decode sparsevec type: %w", pgvector.ErrUnsupportedType)
 	}
 
 	err := scanPlan.Scan(src, &vec)
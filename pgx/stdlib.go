@@ -0,0 +1,33 @@
+package pgx
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// OpenDBOption returns an option for stdlib.OpenDB that registers the types on each new connection.
+// OIDs are cached like with AfterConnect.
+func OpenDBOption(opts ...Option) stdlib.OptionOpenDB {
+	return stdlib.OptionAfterConnect(AfterConnect(opts...))
+}
+
+// decodeDatabaseSQLValue returns the text representation, which is a valid driver.Value.
+func decodeDatabaseSQLValue(c pgtype.Codec, m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+
+	if format == pgx.TextFormatCode {
+		return string(src), nil
+	}
+
+	v, err := c.DecodeValue(m, oid, format, src)
+	if err != nil {
+		return nil, err
+	}
+	return v.(fmt.Stringer).String(), nil
+}
@@ -0,0 +1,29 @@
+package pgx
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type validator interface {
+	Validate() error
+}
+
+// validateEncodePlan wraps a plan to validate values before encoding them.
+func validateEncodePlan(plan pgtype.EncodePlan, validate bool) pgtype.EncodePlan {
+	if !validate {
+		return plan
+	}
+	return encodePlanValidate{next: plan}
+}
+
+type encodePlanValidate struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlanValidate) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	err = value.(validator).Validate()
+	if err != nil {
+		return nil, err
+	}
+	return p.next.Encode(value, buf)
+}
@@ -3,13 +3,20 @@ package pgx
 import (
 	"database/sql/driver"
 	"fmt"
+	"sync"
+	"unsafe"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pgvector/pgvector-go"
 )
 
-type VectorCodec struct{}
+type VectorCodec struct {
+	// Validate checks values against server limits before encoding them.
+	Validate bool
+	// ReuseBuffers decodes into the existing slices of scan targets when they have enough capacity.
+	ReuseBuffers bool
+}
 
 func (VectorCodec) FormatSupported(format int16) bool {
 	return format == pgx.BinaryFormatCode || format == pgx.TextFormatCode
@@ -19,17 +26,30 @@ func (VectorCodec) PreferredFormat() int16 {
 	return pgx.BinaryFormatCode
 }
 
-func (VectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
-	_, ok := value.(pgvector.Vector)
-	if !ok {
+func (c VectorCodec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	switch value.(type) {
+	case pgvector.Vector:
+	case pgvector.NullVector:
+		next := c.PlanEncode(m, oid, format, pgvector.Vector{})
+		if next == nil {
+			return nil
+		}
+		return encodePlanNullVectorCodec{next: next}
+	case []float32, []float64:
+		next := c.PlanEncode(m, oid, format, pgvector.Vector{})
+		if next == nil {
+			return nil
+		}
+		return encodePlanSliceVectorCodec{next: next}
+	default:
 		return nil
 	}
 
 	switch format {
 	case pgx.BinaryFormatCode:
-		return encodePlanVectorCodecBinary{}
+		return validateEncodePlan(encodePlanVectorCodecBinary{}, c.Validate)
 	case pgx.TextFormatCode:
-		return encodePlanVectorCodecText{}
+		return validateEncodePlan(encodePlanVectorCodecText{}, c.Validate)
 	}
 
 	return nil
@@ -50,38 +70,167 @@ func (encodePlanVectorCodecText) Encode(value any, buf []byte) (newBuf []byte, e
 	return append(buf, v.String()...), nil
 }
 
-func (VectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
-	_, ok := target.(*pgvector.Vector)
-	if !ok {
+func (c VectorCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	switch target.(type) {
+	case *pgvector.Vector:
+	case *pgvector.NullVector:
+		next := c.PlanScan(m, oid, format, &pgvector.Vector{})
+		if next == nil {
+			return nil
+		}
+		return scanPlanNullVectorCodec{next: next}
+	case *[]float32, *[]float64:
+		next := c.PlanScan(m, oid, format, &pgvector.Vector{})
+		if next == nil {
+			return nil
+		}
+		return scanPlanSliceVectorCodec{next: next, reuse: c.ReuseBuffers}
+	default:
 		return nil
 	}
 
 	switch format {
 	case pgx.BinaryFormatCode:
-		return scanPlanVectorCodecBinary{}
+		return scanPlanVectorCodecBinary{reuse: c.ReuseBuffers}
 	case pgx.TextFormatCode:
-		return scanPlanVectorCodecText{}
+		return scanPlanVectorCodecText{reuse: c.ReuseBuffers}
 	}
 
 	return nil
 }
 
-type scanPlanVectorCodecBinary struct{}
+type scanPlanVectorCodecBinary struct {
+	reuse bool
+}
 
-func (scanPlanVectorCodecBinary) Scan(src []byte, dst any) error {
+func (p scanPlanVectorCodecBinary) Scan(src []byte, dst any) error {
 	v := (dst).(*pgvector.Vector)
+	if p.reuse {
+		return v.DecodeBinaryInto(src, v.Slice())
+	}
 	return v.DecodeBinary(src)
 }
 
-type scanPlanVectorCodecText struct{}
+type scanPlanVectorCodecText struct {
+	reuse bool
+}
 
-func (scanPlanVectorCodecText) Scan(src []byte, dst any) error {
+func (p scanPlanVectorCodecText) Scan(src []byte, dst any) error {
 	v := (dst).(*pgvector.Vector)
+	if p.reuse {
+		return v.ParseInto(unsafeString(src), v.Slice())
+	}
 	return v.Scan(src)
 }
 
+type encodePlanNullVectorCodec struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlanNullVectorCodec) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	v := value.(pgvector.NullVector)
+	if !v.Valid {
+		return nil, nil
+	}
+	return p.next.Encode(v.Vector, buf)
+}
+
+type scanPlanNullVectorCodec struct {
+	next pgtype.ScanPlan
+}
+
+func (p scanPlanNullVectorCodec) Scan(src []byte, dst any) error {
+	v := (dst).(*pgvector.NullVector)
+	if src == nil {
+		*v = pgvector.NullVector{}
+		return nil
+	}
+	err := p.next.Scan(src, &v.Vector)
+	v.Valid = err == nil
+	return err
+}
+
+type encodePlanSliceVectorCodec struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlanSliceVectorCodec) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	switch v := value.(type) {
+	case []float32:
+		if v == nil {
+			return nil, nil
+		}
+		return p.next.Encode(pgvector.NewVector(v), buf)
+	case []float64:
+		if v == nil {
+			return nil, nil
+		}
+		return p.next.Encode(pgvector.NewVector(toFloat32s(v)), buf)
+	}
+	return nil, fmt.Errorf("unsupported data type: %T", value)
+}
+
+type scanPlanSliceVectorCodec struct {
+	next  pgtype.ScanPlan
+	reuse bool
+}
+
+// vectorPool holds the intermediate vectors for scanning into slices when reusing buffers.
+var vectorPool = sync.Pool{New: func() any { return new(pgvector.Vector) }}
+
+func (p scanPlanSliceVectorCodec) Scan(src []byte, dst any) error {
+	switch dst := dst.(type) {
+	case *[]float32:
+		if src == nil {
+			*dst = nil
+			return nil
+		}
+		if !p.reuse {
+			var v pgvector.Vector
+			err := p.next.Scan(src, &v)
+			if err != nil {
+				return err
+			}
+			*dst = v.Slice()
+			return nil
+		}
+		v := vectorPool.Get().(*pgvector.Vector)
+		*v = pgvector.NewVector(*dst)
+		err := p.next.Scan(src, v)
+		if err == nil {
+			*dst = v.Slice()
+		}
+		// do not keep the slice of dst in the pool
+		*v = pgvector.Vector{}
+		vectorPool.Put(v)
+		return err
+	case *[]float64:
+		if src == nil {
+			*dst = nil
+			return nil
+		}
+		if !p.reuse {
+			var v pgvector.Vector
+			err := p.next.Scan(src, &v)
+			if err != nil {
+				return err
+			}
+			*dst = toFloat64s(v.Slice(), nil)
+			return nil
+		}
+		v := vectorPool.Get().(*pgvector.Vector)
+		defer vectorPool.Put(v)
+		err := p.next.Scan(src, v)
+		if err != nil {
+			return err
+		}
+		*dst = toFloat64s(v.Slice(), *dst)
+	}
+	return nil
+}
+
 func (c VectorCodec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
-	return c.DecodeValue(m, oid, format, src)
+	return decodeDatabaseSQLValue(c, m, oid, format, src)
 }
 
 func (c VectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
@@ -92,7 +241,7 @@ func (c VectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []
 	var vec pgvector.Vector
 	scanPlan := c.PlanScan(m, oid, format, &vec)
 	if scanPlan == nil {
-		return nil, fmt.Errorf("Unable to decode vector type")
+		return nil, fmt.Errorf("Unable to decode vector type: %w", pgvector.ErrUnsupportedType)
 	}
 
 	err := scanPlan.Scan(src, &vec)
@@ -102,3 +251,29 @@ func (c VectorCodec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []
 
 	return vec, nil
 }
+
+func toFloat32s(vec []float64) []float32 {
+	res := make([]float32, len(vec))
+	for i, v := range vec {
+		res[i] = float32(v)
+	}
+	return res
+}
+
+// unsafeString returns a string that shares memory with src to avoid copying it.
+// It must only be passed to functions that do not keep the string, like ParseInto.
+func unsafeString(src []byte) string {
+	return unsafe.String(unsafe.SliceData(src), len(src))
+}
+
+// toFloat64s converts to float64, reusing the capacity of dst.
+func toFloat64s(vec []float32, dst []float64) []float64 {
+	if cap(dst) < len(vec) {
+		dst = make([]float64, len(vec))
+	}
+	dst = dst[:len(vec)]
+	for i, v := range vec {
+		dst[i] = float64(v)
+	}
+	return dst
+}
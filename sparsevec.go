@@ -1,10 +1,11 @@
 package pgvector
 
 import (
+	"cmp"
 	"database/sql"
 	"database/sql/driver"
 	"encoding/binary"
-	"fmt"
+	"encoding/json"
 	"math"
 	"slices"
 	"strconv"
@@ -33,7 +34,18 @@ func NewSparseVector(vec []float32) SparseVector {
 }
 
 // NewSparseVectorFromMap creates a new SparseVector from a map of non-zero elements.
+// It panics if an index is out of bounds. Use NewSparseVectorFromMapE to return an error instead.
 func NewSparseVectorFromMap(elements map[int32]float32, dim int32) SparseVector {
+	v, err := NewSparseVectorFromMapE(elements, dim)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// NewSparseVectorFromMapE creates a new SparseVector from a map of non-zero elements.
+// It returns an error if an index is out of bounds.
+func NewSparseVectorFromMapE(elements map[int32]float32, dim int32) (SparseVector, error) {
 	indices := make([]int32, 0, len(elements))
 	values := make([]float32, 0, len(elements))
 	for k, v := range elements {
@@ -48,10 +60,31 @@ func NewSparseVectorFromMap(elements map[int32]float32, dim int32) SparseVector
 	v := SparseVector{dim: dim, indices: indices, values: values}
 	err := v.validate()
 	if err != nil {
-		// TODO return error in 0.5.0
-		panic(err)
+		return SparseVector{}, err
 	}
-	return v
+	err = v.validateValues()
+	if err != nil {
+		return SparseVector{}, err
+	}
+	return v, nil
+}
+
+// NewSparseVectorFromPairs creates a new SparseVector from indices in ascending order and their values.
+// The slices are used without copying. It returns an error if the lengths differ or an index is out of bounds, unsorted, or duplicated.
+func NewSparseVectorFromPairs(indices []int32, values []float32, dim int32) (SparseVector, error) {
+	if len(indices) != len(values) {
+		return SparseVector{}, newError(ErrInvalidLength, "sparsevec indices and values must have the same length")
+	}
+	v := SparseVector{dim: dim, indices: indices, values: values}
+	err := v.validate()
+	if err != nil {
+		return SparseVector{}, err
+	}
+	err = v.validateValues()
+	if err != nil {
+		return SparseVector{}, err
+	}
+	return v, nil
 }
 
 // Dimensions returns the number of dimensions.
@@ -59,6 +92,16 @@ func (v SparseVector) Dimensions() int32 {
 	return v.dim
 }
 
+// Type returns the SQL type name.
+func (v SparseVector) Type() string {
+	return "sparsevec"
+}
+
+// OpClass returns the operator class for an index with a distance metric, like sparsevec_l2_ops.
+func (v SparseVector) OpClass(metric Metric) (string, error) {
+	return opClass("sparsevec", metric)
+}
+
 // Indices returns the non-zero indices.
 func (v SparseVector) Indices() []int32 {
 	return v.indices
@@ -78,6 +121,11 @@ func (v SparseVector) Slice() []float32 {
 	return vec
 }
 
+// ToDense converts the sparse vector to a vector.
+func (v SparseVector) ToDense() Vector {
+	return Vector{vec: v.Slice()}
+}
+
 // String returns a string representation of the sparse vector.
 func (v SparseVector) String() string {
 	buf := make([]byte, 0, 13+27*len(v.indices))
@@ -100,50 +148,86 @@ func (v SparseVector) String() string {
 
 // Parse parses a string representation of a sparse vector.
 func (v *SparseVector) Parse(s string) error {
-	sp := strings.SplitN(s, "/", 2)
-	if len(sp) != 2 {
-		return fmt.Errorf("malformed sparsevec literal")
+	return v.ParseInto(s, nil, nil)
+}
+
+// ParseInto parses a string representation of a sparse vector, storing the elements in indices and values if they have enough capacity.
+// Pass v.Indices() and v.Values() to reuse the existing slices.
+func (v *SparseVector) ParseInto(s string, indices []int32, values []float32) error {
+	elements, dimStr, found := strings.Cut(s, "/")
+	if !found {
+		return newError(ErrMalformed, "malformed sparsevec literal")
 	}
 
-	dim, err := strconv.ParseInt(sp[1], 10, 32)
+	dim, err := strconv.ParseInt(dimStr, 10, 32)
 	if err != nil {
-		return err
+		return wrapNumError(err)
 	}
 
 	// TODO check brackets in 0.5.0
-	if len(sp[0]) < 2 {
-		return fmt.Errorf("malformed sparsevec literal")
+	if len(elements) < 2 {
+		return newError(ErrMalformed, "malformed sparsevec literal")
 	}
 
-	elements := []string{}
-	if len(sp[0]) > 2 {
-		elements = strings.Split(sp[0][1:len(sp[0])-1], ",")
+	elements = elements[1 : len(elements)-1]
+	nnz := 0
+	if len(elements) > 0 {
+		nnz = strings.Count(elements, ",") + 1
 	}
 
 	v.dim = int32(dim)
-	v.indices = make([]int32, 0, len(elements))
-	v.values = make([]float32, 0, len(elements))
+	v.indices = reuseSlice(indices, nnz)
+	v.values = reuseSlice(values, nnz)
 
-	for i := 0; i < len(elements); i++ {
-		ep := strings.SplitN(elements[i], ":", 2)
-		if len(ep) != 2 {
-			return fmt.Errorf("malformed sparsevec literal")
+	for i := 0; i < nnz; i++ {
+		e, rest, _ := strings.Cut(elements, ",")
+		index, value, found := strings.Cut(e, ":")
+		if !found {
+			v.reset()
+			return newError(ErrMalformed, "malformed sparsevec literal")
 		}
 
-		n, err := strconv.ParseInt(ep[0], 10, 32)
+		n, err := strconv.ParseInt(index, 10, 32)
 		if err != nil {
-			return err
+			v.reset()
+			return wrapNumError(err)
 		}
 		v.indices = append(v.indices, int32(n-1))
 
-		n2, err := strconv.ParseFloat(ep[1], 32)
+		n2, err := strconv.ParseFloat(value, 32)
 		if err != nil {
-			return err
+			v.reset()
+			return wrapNumError(err)
 		}
 		v.values = append(v.values, float32(n2))
+
+		elements = rest
 	}
 
-	return v.validate()
+	err = v.validate()
+	if err != nil {
+		v.reset()
+	}
+	return err
+}
+
+// reset empties the sparse vector after a decoding error.
+// The slices may be buffers passed by the caller, so do not leave invalid data in them.
+func (v *SparseVector) reset() {
+	v.dim = 0
+	v.indices = v.indices[:0]
+	v.values = v.values[:0]
+}
+
+// ParseStrict parses a string representation of a sparse vector using the same rules as the server.
+// It returns a *ParseError if the string is malformed.
+func (v *SparseVector) ParseStrict(s string) error {
+	vec, err := parseSparseStrict(s)
+	if err != nil {
+		return err
+	}
+	*v = vec
+	return nil
 }
 
 // EncodeBinary encodes a binary representation of the sparse vector.
@@ -164,28 +248,34 @@ func (v SparseVector) EncodeBinary(buf []byte) (newBuf []byte, err error) {
 
 // DecodeBinary decodes a binary representation of a sparse vector.
 func (v *SparseVector) DecodeBinary(buf []byte) error {
+	return v.DecodeBinaryInto(buf, nil, nil)
+}
+
+// DecodeBinaryInto decodes a binary representation of a sparse vector, storing the elements in indices and values if they have enough capacity.
+// Pass v.Indices() and v.Values() to reuse the existing slices.
+func (v *SparseVector) DecodeBinaryInto(buf []byte, indices []int32, values []float32) error {
 	if len(buf) < 12 {
-		return fmt.Errorf("invalid length")
+		return newError(ErrInvalidLength, "invalid length")
 	}
 
 	dim := int32(binary.BigEndian.Uint32(buf[0:4]))
 	nnz := int(binary.BigEndian.Uint32(buf[4:8]))
 	if nnz < 0 {
-		return fmt.Errorf("sparsevec cannot have negative number of elements")
+		return newError(ErrInvalidLength, "sparsevec cannot have negative number of elements")
 	}
 
 	unused := binary.BigEndian.Uint32(buf[8:12])
 	if unused != 0 {
-		return fmt.Errorf("expected unused to be 0")
+		return newError(ErrInvalidLength, "expected unused to be 0")
 	}
 
 	if (len(buf)-12)/8 != nnz || (len(buf)-12)%8 != 0 {
-		return fmt.Errorf("invalid length")
+		return newError(ErrInvalidLength, "invalid length")
 	}
 
 	v.dim = dim
-	v.indices = make([]int32, 0, nnz)
-	v.values = make([]float32, 0, nnz)
+	v.indices = reuseSlice(indices, nnz)
+	v.values = reuseSlice(values, nnz)
 	offset := 12
 
 	for i := 0; i < nnz; i++ {
@@ -198,23 +288,223 @@ func (v *SparseVector) DecodeBinary(buf []byte) error {
 		offset += 4
 	}
 
-	return v.validate()
+	err := v.validate()
+	if err != nil {
+		v.reset()
+	}
+	return err
+}
+
+// Validate returns an error if the sparse vector would be rejected by the server.
+func (v SparseVector) Validate() error {
+	err := checkDim("sparsevec", int(v.dim), MaxSparseVectorDimensions)
+	if err != nil {
+		return err
+	}
+
+	if len(v.indices) > MaxSparseVectorNonZero {
+		return newError(ErrTooManyElements, "sparsevec cannot have more than %d non-zero elements", MaxSparseVectorNonZero)
+	}
+
+	err = v.validate()
+	if err != nil {
+		return err
+	}
+
+	err = v.validateValues()
+	if err != nil {
+		return err
+	}
+	return checkElements("sparsevec", v.values)
 }
 
 func (v *SparseVector) validate() error {
+	err := v.validateBounds()
+	if err != nil {
+		return err
+	}
+
+	for i := 1; i < len(v.indices); i++ {
+		if v.indices[i] == v.indices[i-1] {
+			return newError(ErrDuplicateIndex, "sparsevec indices must not contain duplicates")
+		}
+		if v.indices[i] < v.indices[i-1] {
+			return newError(ErrUnsortedIndices, "sparsevec indices must be in ascending order")
+		}
+	}
+
+	return nil
+}
+
+func (v *SparseVector) validateValues() error {
+	for _, value := range v.values {
+		if value == 0 {
+			return newError(ErrZeroValue, "sparsevec values must not be zero")
+		}
+	}
+	return nil
+}
+
+func (v *SparseVector) validateBounds() error {
 	if v.dim < 0 {
-		return fmt.Errorf("sparsevec cannot have negative dimensions")
+		return newError(ErrInvalidDimensions, "sparsevec cannot have negative dimensions")
 	}
 
 	for _, index := range v.indices {
 		if index < 0 || index >= v.dim {
-			return fmt.Errorf("sparsevec index out of bounds")
+			return newError(ErrIndexOutOfBounds, "sparsevec index out of bounds")
 		}
 	}
 
 	return nil
 }
 
+// MergePolicy determines how Canonicalize merges duplicate indices.
+type MergePolicy int
+
+const (
+	// MergeSum adds the values of duplicate indices.
+	MergeSum MergePolicy = iota
+	// MergeMax keeps the largest value of duplicate indices.
+	MergeMax
+	// MergeError returns an error for duplicate indices.
+	MergeError
+)
+
+// NewSparseVectorCanonical creates a new SparseVector from indices and values that may be unsorted,
+// have duplicate indices, or have zero values (like output from other tools).
+func NewSparseVectorCanonical(indices []int32, values []float32, dim int32, policy MergePolicy) (SparseVector, error) {
+	if len(indices) != len(values) {
+		return SparseVector{}, newError(ErrInvalidLength, "sparsevec indices and values must have the same length")
+	}
+	return SparseVector{dim: dim, indices: indices, values: values}.Canonicalize(policy)
+}
+
+// Canonicalize returns the sparse vector with indices sorted, duplicate indices merged, and zero values removed.
+func (v SparseVector) Canonicalize(policy MergePolicy) (SparseVector, error) {
+	err := v.validateBounds()
+	if err != nil {
+		return SparseVector{}, err
+	}
+
+	order := make([]int, len(v.indices))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(v.indices[a], v.indices[b])
+	})
+
+	indices := make([]int32, 0, len(v.indices))
+	values := make([]float32, 0, len(v.values))
+	for _, i := range order {
+		n := len(indices)
+		if n > 0 && indices[n-1] == v.indices[i] {
+			switch policy {
+			case MergeSum:
+				values[n-1] += v.values[i]
+			case MergeMax:
+				values[n-1] = max(values[n-1], v.values[i])
+			default:
+				return SparseVector{}, newError(ErrDuplicateIndex, "sparsevec indices must not contain duplicates")
+			}
+			continue
+		}
+		indices = append(indices, v.indices[i])
+		values = append(values, v.values[i])
+	}
+
+	// remove zeros after merging since values can cancel out
+	n := 0
+	for i := range indices {
+		if values[i] != 0 {
+			indices[n] = indices[i]
+			values[n] = values[i]
+			n++
+		}
+	}
+
+	return SparseVector{dim: v.dim, indices: indices[:n], values: values[:n]}, nil
+}
+
+// Dot returns the inner product of two sparse vectors.
+func (v SparseVector) Dot(o SparseVector) (float64, error) {
+	return InnerProduct(v, o)
+}
+
+// Add returns the elementwise sum of two sparse vectors.
+func (v SparseVector) Add(o SparseVector) (SparseVector, error) {
+	if err := checkSparseDimensions(v, o); err != nil {
+		return SparseVector{}, err
+	}
+
+	n := len(v.indices) + len(o.indices)
+	indices := make([]int32, 0, n)
+	values := make([]float32, 0, n)
+	mergeSparseIndices(v, o, func(index int32, x float32, y float32) {
+		// skip values that cancel out
+		if x+y != 0 {
+			indices = append(indices, index)
+			values = append(values, x+y)
+		}
+	})
+	return SparseVector{dim: v.dim, indices: indices, values: values}, nil
+}
+
+// Scale returns the sparse vector multiplied by a scalar.
+func (v SparseVector) Scale(s float32) SparseVector {
+	return filterSparse(v.dim, v.indices, scale(v.values, s), nonZero)
+}
+
+// Norm returns the Euclidean norm of the sparse vector (like l2_norm).
+func (v SparseVector) Norm() float64 {
+	return norm(v.values)
+}
+
+// Normalize returns the sparse vector with a Euclidean norm of 1 (like l2_normalize).
+func (v SparseVector) Normalize() SparseVector {
+	// values can underflow to zero
+	return filterSparse(v.dim, v.indices, normalize(v.values), nonZero)
+}
+
+// TopK returns the sparse vector with the k elements with the largest absolute values.
+// Ties are broken by keeping lower indices.
+func (v SparseVector) TopK(k int) SparseVector {
+	if k >= len(v.indices) {
+		return filterSparse(v.dim, v.indices, v.values, nonZero)
+	}
+	if k < 1 {
+		return SparseVector{dim: v.dim, indices: []int32{}, values: []float32{}}
+	}
+
+	order := make([]int, len(v.indices))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(math.Abs(float64(v.values[b])), math.Abs(float64(v.values[a])))
+	})
+	order = order[:k]
+	slices.Sort(order)
+
+	indices := make([]int32, 0, k)
+	values := make([]float32, 0, k)
+	for _, i := range order {
+		if v.values[i] != 0 {
+			indices = append(indices, v.indices[i])
+			values = append(values, v.values[i])
+		}
+	}
+	return SparseVector{dim: v.dim, indices: indices, values: values}
+}
+
+// Threshold returns the sparse vector without elements with an absolute value less than or equal to eps.
+func (v SparseVector) Threshold(eps float32) SparseVector {
+	return filterSparse(v.dim, v.indices, v.values, func(x float32) bool {
+		return math.Abs(float64(x)) > float64(eps)
+	})
+}
+
 // statically assert that SparseVector implements sql.Scanner.
 var _ sql.Scanner = (*SparseVector)(nil)
 
@@ -226,7 +516,7 @@ func (v *SparseVector) Scan(src interface{}) (err error) {
 	case string:
 		return v.Parse(src)
 	default:
-		return fmt.Errorf("unsupported data type: %T", src)
+		return newError(ErrUnsupportedType, "unsupported data type: %T", src)
 	}
 }
 
@@ -237,3 +527,111 @@ var _ driver.Valuer = (*SparseVector)(nil)
 func (v SparseVector) Value() (driver.Value, error) {
 	return v.String(), nil
 }
+
+// sparseVectorJSON is used for both JSON representations.
+type sparseVectorJSON struct {
+	Dimensions *int32            `json:"dimensions,omitempty"`
+	Elements   map[int32]float32 `json:"elements,omitempty"`
+	Indices    []int32           `json:"indices,omitempty"`
+	Values     []float32         `json:"values,omitempty"`
+	Dim        *int32            `json:"dim,omitempty"`
+}
+
+// statically assert that SparseVector implements json.Marshaler.
+var _ json.Marshaler = (*SparseVector)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The representation is {"dimensions":6,"elements":{"0":1,"2":2,"4":3}} with indices starting at 0.
+func (v SparseVector) MarshalJSON() ([]byte, error) {
+	elements := make(map[int32]float32, len(v.indices))
+	for i := 0; i < len(v.indices); i++ {
+		elements[v.indices[i]] = v.values[i]
+	}
+	return json.Marshal(struct {
+		Dimensions int32             `json:"dimensions"`
+		Elements   map[int32]float32 `json:"elements"`
+	}{v.dim, elements})
+}
+
+// statically assert that SparseVector implements json.Unmarshaler.
+var _ json.Unmarshaler = (*SparseVector)(nil)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// Both {"dimensions":6,"elements":{"0":1}} and {"indices":[0],"values":[1],"dim":6} are supported.
+// Zero values are dropped.
+func (v *SparseVector) UnmarshalJSON(data []byte) error {
+	// like Vector and HalfVector, null sets the zero value
+	if isJSONNull(data) {
+		*v = SparseVector{}
+		return nil
+	}
+
+	var j sparseVectorJSON
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+
+	if j.Dim != nil {
+		if len(j.Indices) != len(j.Values) {
+			return newError(ErrInvalidLength, "sparsevec indices and values must have the same length")
+		}
+
+		v.dim = *j.Dim
+		v.indices = make([]int32, 0, len(j.Indices))
+		v.values = make([]float32, 0, len(j.Values))
+		// drop zeros like the other form
+		for i, e := range j.Values {
+			if e != 0 {
+				v.indices = append(v.indices, j.Indices[i])
+				v.values = append(v.values, e)
+			}
+		}
+		return v.validate()
+	}
+
+	if j.Dimensions != nil {
+		v.dim = *j.Dimensions
+		v.indices = make([]int32, 0, len(j.Elements))
+		v.values = make([]float32, 0, len(j.Elements))
+		for k, e := range j.Elements {
+			if e != 0 {
+				v.indices = append(v.indices, k)
+			}
+		}
+		slices.Sort(v.indices)
+		for _, k := range v.indices {
+			v.values = append(v.values, j.Elements[k])
+		}
+		return v.validate()
+	}
+
+	return newError(ErrMalformed, "malformed sparsevec json")
+}
+
+// SparseVectorIndicesValues is a wrapper for SparseVector to marshal JSON as {"indices":[0,2,4],"values":[1,2,3],"dim":6}.
+type SparseVectorIndicesValues struct {
+	SparseVector
+}
+
+// statically assert that SparseVectorIndicesValues implements json.Marshaler.
+var _ json.Marshaler = (*SparseVectorIndicesValues)(nil)
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v SparseVectorIndicesValues) MarshalJSON() ([]byte, error) {
+	indices := v.indices
+	if indices == nil {
+		indices = []int32{}
+	}
+	values := v.values
+	if values == nil {
+		values = []float32{}
+	}
+	return json.Marshal(struct {
+		Indices []int32   `json:"indices"`
+		Values  []float32 `json:"values"`
+		Dim     int32     `json:"dim"`
+	}{indices, values, v.dim})
+}
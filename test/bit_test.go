@@ -0,0 +1,128 @@
+package pgvector_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestNewBitVector(t *testing.T) {
+	vec := pgvector.NewBitVector([]bool{true, false, true})
+	if !reflect.DeepEqual(vec.Bools(), []bool{true, false, true}) {
+		t.Error()
+	}
+}
+
+func TestNewBitVectorFromBytes(t *testing.T) {
+	vec := pgvector.NewBitVectorFromBytes([]byte{0b10100000, 0b00000001})
+	if vec.Dimensions() != 16 {
+		t.Error()
+	}
+	if fmt.Sprint(vec) != "1010000000000001" {
+		t.Error()
+	}
+}
+
+func TestBitVectorBytes(t *testing.T) {
+	vec := pgvector.NewBitVector([]bool{true, false, true, false, false, false, false, false, true})
+	if !reflect.DeepEqual(vec.Bytes(), []byte{0b10100000, 0b10000000}) {
+		t.Error()
+	}
+}
+
+func TestBitVectorString(t *testing.T) {
+	vec := pgvector.NewBitVector([]bool{true, false, true})
+	if fmt.Sprint(vec) != "101" {
+		t.Error()
+	}
+}
+
+func TestBitVectorParse(t *testing.T) {
+	var vec pgvector.BitVector
+	err := vec.Parse("101")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Bools(), []bool{true, false, true}) {
+		t.Error()
+	}
+
+	err = vec.Parse("")
+	if err != nil {
+		panic(err)
+	}
+	if vec.Dimensions() != 0 {
+		t.Error()
+	}
+
+	err = vec.Parse("102")
+	if err == nil || err.Error() != "malformed bit literal" {
+		t.Error()
+	}
+}
+
+func TestBitVectorBinary(t *testing.T) {
+	vec := pgvector.NewBitVector([]bool{true, false, true, false, false, false, false, false, true})
+	buf, err := vec.EncodeBinary(nil)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(buf, []byte{0, 0, 0, 9, 0b10100000, 0b10000000}) {
+		t.Error()
+	}
+
+	var vec2 pgvector.BitVector
+	err = vec2.DecodeBinary(buf)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec2, vec) {
+		t.Error()
+	}
+
+	err = vec2.DecodeBinary([]byte{0, 0, 0, 9, 0})
+	if err == nil || err.Error() != "invalid length" {
+		t.Error()
+	}
+
+	// pad bits are ignored
+	err = vec2.DecodeBinary([]byte{0, 0, 0, 3, 0xff})
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec2.Bytes(), []byte{0b11100000}) {
+		t.Error()
+	}
+	distance, err := pgvector.HammingDistance(vec2, pgvector.NewBitVector([]bool{true, true, true}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 0 {
+		t.Error()
+	}
+}
+
+func TestBitVectorMarshal(t *testing.T) {
+	vec := pgvector.NewBitVector([]bool{true, false, true})
+	data, err := json.Marshal(vec)
+	if err != nil {
+		panic(err)
+	}
+	if string(data) != `"101"` {
+		t.Error()
+	}
+}
+
+func TestBitVectorUnmarshal(t *testing.T) {
+	var vec pgvector.BitVector
+	err := json.Unmarshal([]byte(`"101"`), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Bools(), []bool{true, false, true}) {
+		t.Error()
+	}
+}
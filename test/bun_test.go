@@ -20,7 +20,7 @@ type BunItem struct {
 	Id              int64                 `bun:",pk,autoincrement"`
 	Embedding       pgvector.Vector       `bun:"type:vector(3)"`
 	HalfEmbedding   pgvector.HalfVector   `bun:"type:halfvec(3)"`
-	BinaryEmbedding string                `bun:"type:bit(3)"`
+	BinaryEmbedding pgvector.BitVector    `bun:"type:bit(3)"`
 	SparseEmbedding pgvector.SparseVector `bun:"type:sparsevec(3)"`
 	Embeddings      []pgvector.Vector     `bun:"type:vector(3)[]"`
 }
@@ -42,21 +42,21 @@ func CreateBunItems(ctx context.Context, db *bun.DB) {
 		BunItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 1}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 1}),
-			BinaryEmbedding: "000",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{false, false, false}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 1}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{1, 1, 1})},
 		},
 		BunItem{
 			Embedding:       pgvector.NewVector([]float32{2, 2, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{2, 2, 2}),
-			BinaryEmbedding: "101",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, false, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{2, 2, 2}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{2, 2, 2})},
 		},
 		BunItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 2}),
-			BinaryEmbedding: "111",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, true, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 2}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{1, 1, 2})},
 		},
@@ -111,7 +111,7 @@ func TestBun(t *testing.T) {
 	if !reflect.DeepEqual(items[1].HalfEmbedding.Slice(), []float32{1, 1, 2}) {
 		t.Error()
 	}
-	if items[0].BinaryEmbedding != "000" || items[1].BinaryEmbedding != "111" || items[2].BinaryEmbedding != "101" {
+	if items[0].BinaryEmbedding.String() != "000" || items[1].BinaryEmbedding.String() != "111" || items[2].BinaryEmbedding.String() != "101" {
 		t.Error()
 	}
 	if !reflect.DeepEqual(items[1].SparseEmbedding.Slice(), []float32{1, 1, 2}) {
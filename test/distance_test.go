@@ -0,0 +1,182 @@
+package pgvector_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestL2Distance(t *testing.T) {
+	a := pgvector.NewVector([]float32{1, 1, 1})
+	b := pgvector.NewVector([]float32{1, 1, 2})
+	distance, err := pgvector.L2Distance(a, b)
+	if err != nil {
+		panic(err)
+	}
+	if distance != 1 {
+		t.Error()
+	}
+
+	distance, err = pgvector.L2Distance(pgvector.NewHalfVector([]float32{1, 1, 1}), pgvector.NewHalfVector([]float32{2, 2, 2}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != math.Sqrt(3) {
+		t.Error()
+	}
+
+	distance, err = pgvector.L2Distance(pgvector.NewSparseVector([]float32{1, 0, 3}), pgvector.NewSparseVector([]float32{0, 2, 3}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != math.Sqrt(5) {
+		t.Error()
+	}
+
+	_, err = pgvector.L2Distance(a, pgvector.NewVector([]float32{1, 2}))
+	if err == nil || err.Error() != "different vector dimensions 3 and 2" {
+		t.Error()
+	}
+
+	_, err = pgvector.L2Distance(pgvector.NewSparseVector([]float32{1, 2, 3}), pgvector.NewSparseVector([]float32{1, 2}))
+	if err == nil || err.Error() != "different sparsevec dimensions 3 and 2" {
+		t.Error()
+	}
+}
+
+func TestInnerProduct(t *testing.T) {
+	a := pgvector.NewVector([]float32{1, 2, 3})
+	b := pgvector.NewVector([]float32{4, 5, 6})
+	dot, err := pgvector.InnerProduct(a, b)
+	if err != nil {
+		panic(err)
+	}
+	if dot != 32 {
+		t.Error()
+	}
+
+	dot, err = pgvector.MaxInnerProduct(a, b)
+	if err != nil {
+		panic(err)
+	}
+	if dot != -32 {
+		t.Error()
+	}
+
+	dot, err = pgvector.InnerProduct(pgvector.NewSparseVector([]float32{1, 0, 3}), pgvector.NewSparseVector([]float32{0, 2, 3}))
+	if err != nil {
+		panic(err)
+	}
+	if dot != 9 {
+		t.Error()
+	}
+}
+
+func TestCosineDistance(t *testing.T) {
+	distance, err := pgvector.CosineDistance(pgvector.NewVector([]float32{1, 2}), pgvector.NewVector([]float32{2, 4}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 0 {
+		t.Error()
+	}
+
+	distance, err = pgvector.CosineDistance(pgvector.NewHalfVector([]float32{1, 0}), pgvector.NewHalfVector([]float32{0, 1}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 1 {
+		t.Error()
+	}
+
+	distance, err = pgvector.CosineDistance(pgvector.NewSparseVector([]float32{1, 0}), pgvector.NewSparseVector([]float32{-1, 0}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 2 {
+		t.Error()
+	}
+
+	distance, err = pgvector.CosineDistance(pgvector.NewVector([]float32{0, 0}), pgvector.NewVector([]float32{1, 1}))
+	if err != nil {
+		panic(err)
+	}
+	if !math.IsNaN(distance) {
+		t.Error()
+	}
+}
+
+func TestL1Distance(t *testing.T) {
+	distance, err := pgvector.L1Distance(pgvector.NewVector([]float32{1, 2, 3}), pgvector.NewVector([]float32{0, 4, 3}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 3 {
+		t.Error()
+	}
+
+	distance, err = pgvector.L1Distance(pgvector.NewSparseVector([]float32{1, 2, 0}), pgvector.NewSparseVector([]float32{0, 4, 3}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 6 {
+		t.Error()
+	}
+}
+
+func TestHalfVectorDistanceAllocs(t *testing.T) {
+	a := pgvector.NewHalfVector([]float32{1, 1, 1})
+	b := pgvector.NewHalfVector([]float32{2, 2, 2})
+	allocs := testing.AllocsPerRun(100, func() {
+		_, err := pgvector.L2Distance(a, b)
+		if err != nil {
+			panic(err)
+		}
+		_, err = pgvector.CosineDistance(a, b)
+		if err != nil {
+			panic(err)
+		}
+		_, err = a.Dot(b)
+		if err != nil {
+			panic(err)
+		}
+		a.Norm()
+	})
+	if allocs != 0 {
+		t.Error()
+	}
+}
+
+func TestHammingDistance(t *testing.T) {
+	distance, err := pgvector.HammingDistance(pgvector.NewBitVector([]bool{true, false, true}), pgvector.NewBitVector([]bool{true, true, false}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 2 {
+		t.Error()
+	}
+
+	_, err = pgvector.HammingDistance(pgvector.NewBitVector([]bool{true, false, true}), pgvector.NewBitVector([]bool{true, true}))
+	if err == nil || err.Error() != "different bit lengths 3 and 2" {
+		t.Error()
+	}
+}
+
+func TestJaccardDistance(t *testing.T) {
+	distance, err := pgvector.JaccardDistance(pgvector.NewBitVector([]bool{true, false, true}), pgvector.NewBitVector([]bool{true, true, true}))
+	if err != nil {
+		panic(err)
+	}
+	if math.Abs(distance-1.0/3.0) > 1e-12 {
+		t.Error()
+	}
+
+	distance, err = pgvector.JaccardDistance(pgvector.NewBitVector([]bool{false, false, false}), pgvector.NewBitVector([]bool{false, false, false}))
+	if err != nil {
+		panic(err)
+	}
+	if distance != 1 {
+		t.Error()
+	}
+}
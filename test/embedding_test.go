@@ -0,0 +1,80 @@
+package pgvector_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestEmbedding(t *testing.T) {
+	embeddings := []pgvector.Embedding{
+		pgvector.NewVector([]float32{1, 0, 2}),
+		pgvector.NewHalfVector([]float32{1, 0, 2}),
+		pgvector.NewSparseVector([]float32{1, 0, 2}),
+		pgvector.NewBitVector([]bool{true, false, true}),
+	}
+	types := []string{"vector", "halfvec", "sparsevec", "bit"}
+	texts := []string{"[1,0,2]", "[1,0,2]", "{1:1,3:2}/3", "101"}
+
+	for i, embedding := range embeddings {
+		if embedding.Dimensions() != 3 {
+			t.Error()
+		}
+		if embedding.Type() != types[i] {
+			t.Error()
+		}
+		if embedding.String() != texts[i] {
+			t.Error()
+		}
+	}
+
+	if !reflect.DeepEqual(embeddings[3].Slice(), []float32{1, 0, 1}) {
+		t.Error()
+	}
+}
+
+func TestEmbeddingOpClass(t *testing.T) {
+	opClass, err := pgvector.NewVector([]float32{1, 2, 3}).OpClass(pgvector.MetricL2)
+	if err != nil {
+		panic(err)
+	}
+	if opClass != "vector_l2_ops" {
+		t.Error()
+	}
+
+	opClass, err = pgvector.NewHalfVector([]float32{1, 2, 3}).OpClass(pgvector.MetricCosine)
+	if err != nil {
+		panic(err)
+	}
+	if opClass != "halfvec_cosine_ops" {
+		t.Error()
+	}
+
+	opClass, err = pgvector.NewSparseVector([]float32{1, 2, 3}).OpClass(pgvector.MetricInnerProduct)
+	if err != nil {
+		panic(err)
+	}
+	if opClass != "sparsevec_ip_ops" {
+		t.Error()
+	}
+
+	opClass, err = pgvector.NewBitVector([]bool{true}).OpClass(pgvector.MetricJaccard)
+	if err != nil {
+		panic(err)
+	}
+	if opClass != "bit_jaccard_ops" {
+		t.Error()
+	}
+
+	_, err = pgvector.NewBitVector([]bool{true}).OpClass(pgvector.MetricL2)
+	if !errors.Is(err, pgvector.ErrUnsupportedMetric) || err.Error() != "unsupported metric for bit: l2" {
+		t.Error()
+	}
+
+	_, err = pgvector.NewVector([]float32{1}).OpClass(pgvector.MetricHamming)
+	if !errors.Is(err, pgvector.ErrUnsupportedMetric) {
+		t.Error()
+	}
+}
@@ -25,7 +25,7 @@ func (Item) Fields() []ent.Field {
 			SchemaType(map[string]string{
 				dialect.Postgres: "halfvec(3)",
 			}),
-		field.String("binary_embedding").
+		field.Other("binary_embedding", pgvector.BitVector{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "bit(3)",
 			}),
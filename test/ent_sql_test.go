@@ -0,0 +1,30 @@
+package pgvector_test
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/pgvector/pgvector-go"
+	entvec "github.com/pgvector/pgvector-go/ent"
+)
+
+func TestEntDistanceSchema(t *testing.T) {
+	embedding := pgvector.NewVector([]float32{1, 1, 1})
+
+	query, args := sql.Dialect(dialect.Postgres).Select("id").From(sql.Table("ent_items")).OrderExpr(entvec.L2Distance("embedding", embedding, entvec.WithSchema("extensions"))).Query()
+	if !strings.Contains(query, `"embedding" OPERATOR("extensions".<->) $1`) || len(args) != 1 {
+		t.Error()
+	}
+
+	query, _ = sql.Dialect(dialect.Postgres).Select("id").From(sql.Table("ent_items")).OrderExpr(entvec.CosineDistance("embedding", embedding, entvec.WithSchema(`my"schema`))).Query()
+	if !strings.Contains(query, `OPERATOR("my""schema".<=>)`) {
+		t.Error()
+	}
+
+	query, _ = sql.Dialect(dialect.Postgres).Select("id").From(sql.Table("ent_items")).OrderExpr(entvec.L2Distance("embedding", embedding)).Query()
+	if !strings.Contains(query, `"embedding" <-> $1`) {
+		t.Error()
+	}
+}
@@ -38,7 +38,7 @@ func TestEnt(t *testing.T) {
 
 	embedding := pgvector.NewVector([]float32{1, 1, 1})
 	halfEmbedding := pgvector.NewHalfVector([]float32{1, 1, 1})
-	binaryEmbedding := "000"
+	binaryEmbedding := pgvector.NewBitVector([]bool{false, false, false})
 	sparseEmbedding := pgvector.NewSparseVector([]float32{1, 1, 1})
 	_, err = client.Item.Create().
 		SetEmbedding(embedding).
@@ -53,12 +53,12 @@ func TestEnt(t *testing.T) {
 		client.Item.Create().
 			SetEmbedding(pgvector.NewVector([]float32{2, 2, 2})).
 			SetHalfEmbedding(pgvector.NewHalfVector([]float32{2, 2, 2})).
-			SetBinaryEmbedding("101").
+			SetBinaryEmbedding(pgvector.NewBitVector([]bool{true, false, true})).
 			SetSparseEmbedding(pgvector.NewSparseVector([]float32{2, 2, 2})),
 		client.Item.Create().
 			SetEmbedding(pgvector.NewVector([]float32{1, 1, 2})).
 			SetHalfEmbedding(pgvector.NewHalfVector([]float32{1, 1, 2})).
-			SetBinaryEmbedding("111").
+			SetBinaryEmbedding(pgvector.NewBitVector([]bool{true, true, true})).
 			SetSparseEmbedding(pgvector.NewSparseVector([]float32{1, 1, 2})),
 	).Save(ctx)
 	if err != nil {
@@ -88,6 +88,20 @@ func TestEnt(t *testing.T) {
 		t.Error()
 	}
 
+	items, err = client.Item.
+		Query().
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(entvec.L2Distance("embedding", embedding, entvec.WithSchema("public")))
+		}).
+		Limit(5).
+		All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if items[0].ID != 1 || items[1].ID != 3 || items[2].ID != 2 {
+		t.Error()
+	}
+
 	items, err = client.Item.
 		Query().
 		Order(func(s *sql.Selector) {
@@ -133,7 +147,7 @@ func TestEnt(t *testing.T) {
 	items, err = client.Item.
 		Query().
 		Order(func(s *sql.Selector) {
-			s.OrderExpr(entvec.HammingDistance("binary_embedding", "101"))
+			s.OrderExpr(entvec.HammingDistance("binary_embedding", pgvector.NewBitVector([]bool{true, false, true})))
 		}).
 		Limit(5).
 		All(ctx)
@@ -147,7 +161,7 @@ func TestEnt(t *testing.T) {
 	items, err = client.Item.
 		Query().
 		Order(func(s *sql.Selector) {
-			s.OrderExpr(entvec.JaccardDistance("binary_embedding", "101"))
+			s.OrderExpr(entvec.JaccardDistance("binary_embedding", pgvector.NewBitVector([]bool{true, false, true})))
 		}).
 		Limit(5).
 		All(ctx)
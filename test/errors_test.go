@@ -0,0 +1,108 @@
+package pgvector_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestErrMalformed(t *testing.T) {
+	var vec pgvector.Vector
+	err := vec.Parse("")
+	if !errors.Is(err, pgvector.ErrMalformed) || err.Error() != "malformed vector literal" {
+		t.Error()
+	}
+
+	err = vec.Parse("[a]")
+	if !errors.Is(err, pgvector.ErrMalformed) || !errors.Is(err, strconv.ErrSyntax) {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("(1)")
+	if !errors.Is(err, pgvector.ErrMalformed) {
+		t.Error()
+	}
+
+	var bits pgvector.BitVector
+	err = bits.Parse("2")
+	if !errors.Is(err, pgvector.ErrMalformed) {
+		t.Error()
+	}
+}
+
+func TestErrOutOfRange(t *testing.T) {
+	var vec pgvector.HalfVector
+	err := vec.Parse("[4e38]")
+	if !errors.Is(err, pgvector.ErrOutOfRange) || !errors.Is(err, strconv.ErrRange) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewVector([]float32{70000}).ToHalfChecked()
+	if !errors.Is(err, pgvector.ErrOutOfRange) {
+		t.Error()
+	}
+}
+
+func TestErrUnsupportedType(t *testing.T) {
+	var vec pgvector.Vector
+	err := vec.Scan(1)
+	if !errors.Is(err, pgvector.ErrUnsupportedType) || err.Error() != "unsupported data type: int" {
+		t.Error()
+	}
+}
+
+func TestErrInvalidLength(t *testing.T) {
+	var vec pgvector.Vector
+	err := vec.DecodeBinary([]byte{0, 1})
+	if !errors.Is(err, pgvector.ErrInvalidLength) || err.Error() != "invalid length" {
+		t.Error()
+	}
+
+	var sparseVec pgvector.SparseVector
+	err = sparseVec.DecodeBinary([]byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1})
+	if !errors.Is(err, pgvector.ErrInvalidLength) || err.Error() != "expected unused to be 0" {
+		t.Error()
+	}
+}
+
+func TestErrDimensionMismatch(t *testing.T) {
+	_, err := pgvector.L2Distance(pgvector.NewVector([]float32{1}), pgvector.NewVector([]float32{1, 2}))
+	if !errors.Is(err, pgvector.ErrDimensionMismatch) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewHalfVector([]float32{1}).Add(pgvector.NewHalfVector([]float32{1, 2}))
+	if !errors.Is(err, pgvector.ErrDimensionMismatch) {
+		t.Error()
+	}
+}
+
+func TestErrTooManyDimensions(t *testing.T) {
+	_, err := pgvector.NewVector(make([]float32, 16000)).Concat(pgvector.NewVector([]float32{1}))
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) {
+		t.Error()
+	}
+}
+
+func TestErrIndexOutOfBounds(t *testing.T) {
+	var vec pgvector.SparseVector
+	err := vec.Parse("{7:1}/6")
+	if !errors.Is(err, pgvector.ErrIndexOutOfBounds) || err.Error() != "sparsevec index out of bounds" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{7:1}/6")
+	if !errors.Is(err, pgvector.ErrIndexOutOfBounds) {
+		t.Error()
+	}
+}
+
+func TestErrNonFinite(t *testing.T) {
+	var vec pgvector.Vector
+	err := vec.ParseStrict("[NaN]")
+	if !errors.Is(err, pgvector.ErrNonFinite) {
+		t.Error()
+	}
+}
@@ -15,7 +15,7 @@ type GormItem struct {
 	gorm.Model
 	Embedding       pgvector.Vector       `gorm:"type:vector(3)"`
 	HalfEmbedding   pgvector.HalfVector   `gorm:"type:halfvec(3)"`
-	BinaryEmbedding string                `gorm:"type:bit(3)"`
+	BinaryEmbedding pgvector.BitVector    `gorm:"type:bit(3)"`
 	SparseEmbedding pgvector.SparseVector `gorm:"type:sparsevec(3)"`
 }
 
@@ -24,19 +24,19 @@ func CreateGormItems(db *gorm.DB) {
 		GormItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 1}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 1}),
-			BinaryEmbedding: "000",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{false, false, false}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 1}),
 		},
 		GormItem{
 			Embedding:       pgvector.NewVector([]float32{2, 2, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{2, 2, 2}),
-			BinaryEmbedding: "101",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, false, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{2, 2, 2}),
 		},
 		GormItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 2}),
-			BinaryEmbedding: "111",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, true, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 2}),
 		},
 	}
@@ -79,7 +79,7 @@ func TestGorm(t *testing.T) {
 	if !reflect.DeepEqual(items[1].HalfEmbedding.Slice(), []float32{1, 1, 2}) {
 		t.Error()
 	}
-	if items[0].BinaryEmbedding != "000" || items[1].BinaryEmbedding != "111" || items[2].BinaryEmbedding != "101" {
+	if items[0].BinaryEmbedding.String() != "000" || items[1].BinaryEmbedding.String() != "111" || items[2].BinaryEmbedding.String() != "101" {
 		t.Error()
 	}
 	if !reflect.DeepEqual(items[1].SparseEmbedding.Slice(), []float32{1, 1, 2}) {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"testing"
@@ -16,6 +17,13 @@ func TestHalfVectorSlice(t *testing.T) {
 	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
 		t.Error()
 	}
+
+	// returns a copy
+	slice := vec.Slice()
+	slice[0] = 2
+	if vec.Slice()[0] != 1 {
+		t.Error()
+	}
 }
 
 func TestHalfVectorString(t *testing.T) {
@@ -80,3 +88,297 @@ func TestHalfVectorUnmarshal(t *testing.T) {
 		t.Error()
 	}
 }
+
+func TestHalfVectorAdd(t *testing.T) {
+	vec, err := pgvector.NewHalfVector([]float32{1, 2, 3}).Add(pgvector.NewHalfVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{5, 7, 9}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewHalfVector([]float32{1, 2, 3}).Add(pgvector.NewHalfVector([]float32{1, 2}))
+	if err == nil || err.Error() != "different halfvec dimensions 3 and 2" {
+		t.Error()
+	}
+}
+
+func TestHalfVectorSub(t *testing.T) {
+	vec, err := pgvector.NewHalfVector([]float32{1, 2, 3}).Sub(pgvector.NewHalfVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{-3, -3, -3}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorMul(t *testing.T) {
+	vec, err := pgvector.NewHalfVector([]float32{1, 2, 3}).Mul(pgvector.NewHalfVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{4, 10, 18}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorScale(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1, 2, 3}).Scale(2)
+	if !reflect.DeepEqual(vec.Slice(), []float32{2, 4, 6}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorDot(t *testing.T) {
+	dot, err := pgvector.NewHalfVector([]float32{1, 2, 3}).Dot(pgvector.NewHalfVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if dot != 32 {
+		t.Error()
+	}
+}
+
+func TestHalfVectorNorm(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{3, 4})
+	if vec.Norm() != 5 {
+		t.Error()
+	}
+}
+
+func TestHalfVectorNormalize(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{3, 4}).Normalize()
+	if !reflect.DeepEqual(vec.Slice(), pgvector.NewHalfVector([]float32{0.6, 0.8}).Slice()) {
+		t.Error()
+	}
+
+	vec = pgvector.NewHalfVector([]float32{0, 0}).Normalize()
+	if !reflect.DeepEqual(vec.Slice(), []float32{0, 0}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorSubvector(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1, 2, 3, 4, 5})
+	sub, err := vec.Subvector(2, 3)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sub.Slice(), []float32{2, 3, 4}) {
+		t.Error()
+	}
+
+	sub, err = vec.Subvector(3, 9)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sub.Slice(), []float32{3, 4, 5}) {
+		t.Error()
+	}
+
+	sub, err = vec.Subvector(-1, 3)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sub.Slice(), []float32{1}) {
+		t.Error()
+	}
+
+	_, err = vec.Subvector(1, 0)
+	if err == nil || err.Error() != "halfvec must have at least 1 dimension" {
+		t.Error()
+	}
+
+	_, err = vec.Subvector(6, 1)
+	if err == nil || err.Error() != "halfvec must have at least 1 dimension" {
+		t.Error()
+	}
+
+	_, err = vec.Subvector(-5, 3)
+	if err == nil || err.Error() != "halfvec must have at least 1 dimension" {
+		t.Error()
+	}
+}
+
+func TestHalfVectorConcat(t *testing.T) {
+	vec, err := pgvector.NewHalfVector([]float32{1, 2}).Concat(pgvector.NewHalfVector([]float32{3}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewHalfVector(make([]float32, 16000)).Concat(pgvector.NewHalfVector([]float32{1}))
+	if err == nil || err.Error() != "halfvec cannot have more than 16000 dimensions" {
+		t.Error()
+	}
+}
+
+func TestHalfVectorTruncate(t *testing.T) {
+	vec, err := pgvector.NewHalfVector([]float32{3, 4, 5}).Truncate(2)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), pgvector.NewHalfVector([]float32{0.6, 0.8}).Slice()) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorBinaryQuantize(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1, -2, 0, 3, 4, 5, 6, 7, 8}).BinaryQuantize()
+	if fmt.Sprint(vec) != "100111111" {
+		t.Error()
+	}
+}
+
+func TestHalfVectorToVector(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1, 2, 3}).ToVector()
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorBits(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1, 2, 3})
+	if !reflect.DeepEqual(vec.Bits(), []uint16{0x3c00, 0x4000, 0x4200}) {
+		t.Error()
+	}
+}
+
+func TestNewHalfVectorFromBits(t *testing.T) {
+	vec := pgvector.NewHalfVectorFromBits([]uint16{0x3c00, 0x4000, 0x4200})
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorRounding(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1.0001, 65520, 1e-8})
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, float32(math.Inf(1)), 0}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorBinary(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{1, 2, 3})
+	buf, err := vec.EncodeBinary(nil)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(buf, []byte{0, 3, 0, 0, 0x3c, 0, 0x40, 0, 0x42, 0}) {
+		t.Error()
+	}
+
+	var vec2 pgvector.HalfVector
+	err = vec2.DecodeBinary(buf)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec2.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	err = vec2.DecodeBinary([]byte{0, 3, 0, 0})
+	if err == nil || err.Error() != "invalid length" {
+		t.Error()
+	}
+
+	err = vec2.DecodeBinary([]byte{0, 0, 0, 1})
+	if err == nil || err.Error() != "expected unused to be 0" {
+		t.Error()
+	}
+}
+
+func TestHalfVectorParseStrict(t *testing.T) {
+	var vec pgvector.HalfVector
+	err := vec.ParseStrict("[1, 2, 3]")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	var parseErr *pgvector.ParseError
+	err = vec.ParseStrict("[1,70000]")
+	if !errors.As(err, &parseErr) || parseErr.Index != 1 || !errors.Is(err, pgvector.ErrOutOfRange) || err.Error() != "malformed halfvec literal at offset 3 (element 1): \"70000\" is out of range for type halfvec" {
+		t.Error()
+	}
+	ones := make([]float32, 16001)
+	for i := range ones {
+		ones[i] = 1
+	}
+	err = vec.ParseStrict(pgvector.NewHalfVector(ones[:16000]).String())
+	if err != nil {
+		panic(err)
+	}
+	err = vec.ParseStrict(pgvector.NewHalfVector(ones).String())
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) || !errors.As(err, &parseErr) || parseErr.Index != 16000 {
+		t.Error()
+	}
+}
+
+func TestHalfVectorValidate(t *testing.T) {
+	err := pgvector.NewHalfVector([]float32{1, 2, 3}).Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgvector.NewHalfVector(make([]float32, 16001)).Validate()
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) || err.Error() != "halfvec cannot have more than 16000 dimensions" {
+		t.Error()
+	}
+
+	err = pgvector.NewHalfVector([]float32{1, 70000}).Validate()
+	if !errors.Is(err, pgvector.ErrNonFinite) || err.Error() != "infinite value not allowed in halfvec" {
+		t.Error()
+	}
+
+	err = pgvector.NewHalfVectorFromBits([]uint16{0x7e00}).Validate()
+	if !errors.Is(err, pgvector.ErrNonFinite) || err.Error() != "NaN not allowed in halfvec" {
+		t.Error()
+	}
+}
+
+func TestHalfVectorDecodeBinaryInto(t *testing.T) {
+	buf, err := pgvector.NewHalfVector([]float32{1, 2, 3}).EncodeBinary(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	vec := pgvector.NewHalfVector([]float32{4, 5, 6})
+	allocs := testing.AllocsPerRun(100, func() {
+		err := vec.DecodeBinaryInto(buf, vec.Bits())
+		if err != nil {
+			panic(err)
+		}
+	})
+	if allocs != 0 {
+		t.Error()
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+}
+
+func TestHalfVectorParseInto(t *testing.T) {
+	vec := pgvector.NewHalfVector([]float32{4, 5, 6})
+	err := vec.ParseInto("[1,2,3]", vec.Bits())
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	err = vec.ParseInto("[4,x]", vec.Bits())
+	if err == nil {
+		t.Error()
+	}
+	if len(vec.Slice()) != 0 {
+		t.Error()
+	}
+}
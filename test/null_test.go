@@ -0,0 +1,148 @@
+package pgvector_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestNullVectorScan(t *testing.T) {
+	var vec pgvector.NullVector
+	err := vec.Scan(nil)
+	if err != nil {
+		panic(err)
+	}
+	if vec.Valid {
+		t.Error()
+	}
+
+	err = vec.Scan("[1,2,3]")
+	if err != nil {
+		panic(err)
+	}
+	if !vec.Valid || !reflect.DeepEqual(vec.Vector.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	err = vec.Scan(1)
+	if err == nil || vec.Valid {
+		t.Error()
+	}
+}
+
+func TestNullVectorValue(t *testing.T) {
+	value, err := pgvector.NullVector{}.Value()
+	if err != nil {
+		panic(err)
+	}
+	if value != nil {
+		t.Error()
+	}
+
+	value, err = pgvector.NullVector{Vector: pgvector.NewVector([]float32{1, 2, 3}), Valid: true}.Value()
+	if err != nil {
+		panic(err)
+	}
+	if value != "[1,2,3]" {
+		t.Error()
+	}
+}
+
+func TestNullVectorJSON(t *testing.T) {
+	data, err := json.Marshal(pgvector.NullVector{})
+	if err != nil {
+		panic(err)
+	}
+	if string(data) != "null" {
+		t.Error()
+	}
+
+	var vec pgvector.NullVector
+	err = json.Unmarshal([]byte("[1,2,3]"), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if !vec.Valid || !reflect.DeepEqual(vec.Vector.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte("null"), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if vec.Valid {
+		t.Error()
+	}
+}
+
+func TestNullHalfVector(t *testing.T) {
+	var vec pgvector.NullHalfVector
+	err := vec.Scan(nil)
+	if err != nil {
+		panic(err)
+	}
+	if vec.Valid {
+		t.Error()
+	}
+
+	err = vec.Scan("[1,2,3]")
+	if err != nil {
+		panic(err)
+	}
+	if !vec.Valid || !reflect.DeepEqual(vec.HalfVector.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	value, err := pgvector.NullHalfVector{}.Value()
+	if err != nil {
+		panic(err)
+	}
+	if value != nil {
+		t.Error()
+	}
+
+	data, err := json.Marshal(vec)
+	if err != nil {
+		panic(err)
+	}
+	if string(data) != "[1,2,3]" {
+		t.Error()
+	}
+}
+
+func TestNullSparseVector(t *testing.T) {
+	var vec pgvector.NullSparseVector
+	err := vec.Scan(nil)
+	if err != nil {
+		panic(err)
+	}
+	if vec.Valid {
+		t.Error()
+	}
+
+	err = vec.Scan("{1:1,3:2}/3")
+	if err != nil {
+		panic(err)
+	}
+	if !vec.Valid || !reflect.DeepEqual(vec.SparseVector.Slice(), []float32{1, 0, 2}) {
+		t.Error()
+	}
+
+	value, err := pgvector.NullSparseVector{}.Value()
+	if err != nil {
+		panic(err)
+	}
+	if value != nil {
+		t.Error()
+	}
+
+	data, err := json.Marshal(pgvector.NullSparseVector{})
+	if err != nil {
+		panic(err)
+	}
+	if string(data) != "null" {
+		t.Error()
+	}
+}
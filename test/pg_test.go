@@ -17,7 +17,7 @@ type PgItem struct {
 	Id              int64
 	Embedding       pgvector.Vector       `pg:"type:vector(3)"`
 	HalfEmbedding   pgvector.HalfVector   `pg:"type:halfvec(3)"`
-	BinaryEmbedding string                `pg:"type:bit(3)"`
+	BinaryEmbedding pgvector.BitVector    `pg:"type:bit(3)"`
 	SparseEmbedding pgvector.SparseVector `pg:"type:sparsevec(3)"`
 	Embeddings      []pgvector.Vector     `pg:"type:vector(3)[]"`
 }
@@ -27,21 +27,21 @@ func CreatePgItems(db *pg.DB) {
 		PgItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 1}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 1}),
-			BinaryEmbedding: "000",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{false, false, false}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 1}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{1, 1, 1})},
 		},
 		PgItem{
 			Embedding:       pgvector.NewVector([]float32{2, 2, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{2, 2, 2}),
-			BinaryEmbedding: "101",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, false, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{2, 2, 2}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{2, 2, 2})},
 		},
 		PgItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 2}),
-			BinaryEmbedding: "111",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, true, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 2}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{1, 1, 2})},
 		},
@@ -99,7 +99,7 @@ func TestPg(t *testing.T) {
 	if !reflect.DeepEqual(items[1].HalfEmbedding.Slice(), []float32{1, 1, 2}) {
 		t.Error()
 	}
-	if items[0].BinaryEmbedding != "000" || items[1].BinaryEmbedding != "111" || items[2].BinaryEmbedding != "101" {
+	if items[0].BinaryEmbedding.String() != "000" || items[1].BinaryEmbedding.String() != "111" || items[2].BinaryEmbedding.String() != "101" {
 		t.Error()
 	}
 	if !reflect.DeepEqual(items[1].SparseEmbedding.Slice(), []float32{1, 1, 2}) {
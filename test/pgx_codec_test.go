@@ -0,0 +1,238 @@
+package pgvector_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pgvector/pgvector-go"
+	pgxvec "github.com/pgvector/pgvector-go/pgx"
+)
+
+// fake OIDs since the codecs do not depend on them
+const (
+	vectorOid    = 90001
+	halfvecOid   = 90002
+	sparsevecOid = 90003
+)
+
+func newPgxCodecMap(reuse bool) *pgtype.Map {
+	m := pgtype.NewMap()
+	m.RegisterType(&pgtype.Type{Name: "vector", OID: vectorOid, Codec: &pgxvec.VectorCodec{ReuseBuffers: reuse}})
+	m.RegisterType(&pgtype.Type{Name: "halfvec", OID: halfvecOid, Codec: &pgxvec.HalfVectorCodec{ReuseBuffers: reuse}})
+	m.RegisterType(&pgtype.Type{Name: "sparsevec", OID: sparsevecOid, Codec: &pgxvec.SparseVectorCodec{ReuseBuffers: reuse}})
+	return m
+}
+
+func TestPgxCodecReuseBuffers(t *testing.T) {
+	m := newPgxCodecMap(true)
+
+	for _, format := range []int16{pgx.BinaryFormatCode, pgx.TextFormatCode} {
+		src, err := m.Encode(vectorOid, format, pgvector.NewVector([]float32{1, 2, 3}), nil)
+		if err != nil {
+			panic(err)
+		}
+		vec := pgvector.NewVector([]float32{4, 5, 6})
+		data := vec.Slice()
+		// like rows, plan once and scan each row
+		plan := m.PlanScan(vectorOid, format, &vec)
+		allocs := testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &vec)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &vec.Slice()[0] != &data[0] || !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+			t.Error()
+		}
+
+		float32s := []float32{4, 5, 6}
+		data = float32s
+		plan = m.PlanScan(vectorOid, format, &float32s)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &float32s)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &float32s[0] != &data[0] || !reflect.DeepEqual(float32s, []float32{1, 2, 3}) {
+			t.Error()
+		}
+
+		float64s := []float64{4, 5, 6}
+		float64Data := float64s
+		plan = m.PlanScan(vectorOid, format, &float64s)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &float64s)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &float64s[0] != &float64Data[0] || !reflect.DeepEqual(float64s, []float64{1, 2, 3}) {
+			t.Error()
+		}
+
+		src, err = m.Encode(halfvecOid, format, pgvector.NewHalfVector([]float32{1, 2, 3}), nil)
+		if err != nil {
+			panic(err)
+		}
+		halfVec := pgvector.NewHalfVector([]float32{4, 5, 6})
+		bits := halfVec.Bits()
+		plan = m.PlanScan(halfvecOid, format, &halfVec)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &halfVec)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &halfVec.Bits()[0] != &bits[0] || !reflect.DeepEqual(halfVec.Slice(), []float32{1, 2, 3}) {
+			t.Error()
+		}
+
+		slice := []float32{4, 5, 6}
+		data = slice
+		plan = m.PlanScan(halfvecOid, format, &slice)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &slice)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &slice[0] != &data[0] || !reflect.DeepEqual(slice, []float32{1, 2, 3}) {
+			t.Error()
+		}
+
+		float64s = []float64{4, 5, 6}
+		float64Data = float64s
+		plan = m.PlanScan(halfvecOid, format, &float64s)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &float64s)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &float64s[0] != &float64Data[0] || !reflect.DeepEqual(float64s, []float64{1, 2, 3}) {
+			t.Error()
+		}
+
+		src, err = m.Encode(sparsevecOid, format, pgvector.NewSparseVector([]float32{1, 0, 2}), nil)
+		if err != nil {
+			panic(err)
+		}
+		sparseVec := pgvector.NewSparseVector([]float32{4, 5, 6})
+		indices := sparseVec.Indices()
+		plan = m.PlanScan(sparsevecOid, format, &sparseVec)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &sparseVec)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || &sparseVec.Indices()[0] != &indices[0] || !reflect.DeepEqual(sparseVec.Slice(), []float32{1, 0, 2}) {
+			t.Error()
+		}
+	}
+}
+
+func TestPgxCodecReuseBuffersLarge(t *testing.T) {
+	m := newPgxCodecMap(true)
+
+	// text values over 32 bytes cannot be converted to strings on the stack
+	elements := make([]float32, 1536)
+	for i := range elements {
+		elements[i] = float32(i) + 0.5
+	}
+
+	for _, format := range []int16{pgx.BinaryFormatCode, pgx.TextFormatCode} {
+		src, err := m.Encode(vectorOid, format, pgvector.NewVector(elements), nil)
+		if err != nil {
+			panic(err)
+		}
+		vec := pgvector.NewVector(make([]float32, 1536))
+		plan := m.PlanScan(vectorOid, format, &vec)
+		allocs := testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &vec)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || !reflect.DeepEqual(vec.Slice(), elements) {
+			t.Error()
+		}
+
+		src, err = m.Encode(halfvecOid, format, pgvector.NewHalfVector(elements), nil)
+		if err != nil {
+			panic(err)
+		}
+		halfVec := pgvector.NewHalfVector(make([]float32, 1536))
+		plan = m.PlanScan(halfvecOid, format, &halfVec)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &halfVec)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || !reflect.DeepEqual(halfVec.Slice(), pgvector.NewHalfVector(elements).Slice()) {
+			t.Error()
+		}
+
+		src, err = m.Encode(sparsevecOid, format, pgvector.NewSparseVector(elements), nil)
+		if err != nil {
+			panic(err)
+		}
+		sparseVec := pgvector.NewSparseVector(elements)
+		plan = m.PlanScan(sparsevecOid, format, &sparseVec)
+		allocs = testing.AllocsPerRun(100, func() {
+			err := plan.Scan(src, &sparseVec)
+			if err != nil {
+				panic(err)
+			}
+		})
+		if allocs != 0 || !reflect.DeepEqual(sparseVec.Slice(), elements) {
+			t.Error()
+		}
+	}
+}
+
+func TestPgxCodecDefault(t *testing.T) {
+	m := newPgxCodecMap(false)
+
+	src, err := m.Encode(vectorOid, pgx.BinaryFormatCode, pgvector.NewVector([]float32{1, 2, 3}), nil)
+	if err != nil {
+		panic(err)
+	}
+	vec := pgvector.NewVector([]float32{4, 5, 6})
+	data := vec.Slice()
+	err = m.Scan(vectorOid, pgx.BinaryFormatCode, src, &vec)
+	if err != nil {
+		panic(err)
+	}
+	if &vec.Slice()[0] == &data[0] || !reflect.DeepEqual(data, []float32{4, 5, 6}) {
+		t.Error()
+	}
+
+	float64s := []float64{4, 5, 6}
+	float64Data := float64s
+	err = m.Scan(vectorOid, pgx.BinaryFormatCode, src, &float64s)
+	if err != nil {
+		panic(err)
+	}
+	if &float64s[0] == &float64Data[0] || !reflect.DeepEqual(float64Data, []float64{4, 5, 6}) || !reflect.DeepEqual(float64s, []float64{1, 2, 3}) {
+		t.Error()
+	}
+
+	src, err = m.Encode(halfvecOid, pgx.BinaryFormatCode, pgvector.NewHalfVector([]float32{1, 2, 3}), nil)
+	if err != nil {
+		panic(err)
+	}
+	slice := []float32{4, 5, 6}
+	data = slice
+	err = m.Scan(halfvecOid, pgx.BinaryFormatCode, src, &slice)
+	if err != nil {
+		panic(err)
+	}
+	if &slice[0] == &data[0] || !reflect.DeepEqual(data, []float32{4, 5, 6}) {
+		t.Error()
+	}
+}
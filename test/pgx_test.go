@@ -2,6 +2,7 @@ package pgvector_test
 
 import (
 	"context"
+	"errors"
 	"math"
 	"reflect"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pgvector/pgvector-go"
 	pgxvec "github.com/pgvector/pgvector-go/pgx"
 )
@@ -17,7 +19,7 @@ type PgxItem struct {
 	Id              int64
 	Embedding       pgvector.Vector
 	HalfEmbedding   pgvector.HalfVector
-	BinaryEmbedding string
+	BinaryEmbedding pgvector.BitVector
 	SparseEmbedding pgvector.SparseVector
 	Embeddings      []pgvector.Vector
 }
@@ -27,21 +29,21 @@ func CreatePgxItems(ctx context.Context, conn *pgx.Conn) {
 		PgxItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 1}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 1}),
-			BinaryEmbedding: "000",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{false, false, false}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 1}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{1, 1, 1})},
 		},
 		PgxItem{
 			Embedding:       pgvector.NewVector([]float32{2, 2, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{2, 2, 2}),
-			BinaryEmbedding: "101",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, false, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{2, 2, 2}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{2, 2, 2})},
 		},
 		PgxItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 2}),
-			BinaryEmbedding: "111",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, true, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 2}),
 			Embeddings:      []pgvector.Vector{pgvector.NewVector([]float32{1, 1, 2})},
 		},
@@ -151,7 +153,7 @@ func TestPgx(t *testing.T) {
 	if !reflect.DeepEqual(item.HalfEmbedding.Slice(), []float32{1, 1, 2}) {
 		t.Error()
 	}
-	if item.BinaryEmbedding != "111" {
+	if item.BinaryEmbedding.String() != "111" {
 		t.Error()
 	}
 	if !reflect.DeepEqual(item.SparseEmbedding.Slice(), []float32{1, 1, 2}) {
@@ -170,13 +172,105 @@ func TestPgx(t *testing.T) {
 		panic(err)
 	}
 
-	config, err := pgxpool.ParseConfig("postgres://localhost/pgvector_go_test")
+	_, err = conn.CopyFrom(
+		ctx,
+		pgx.Identifier{"pgx_items"},
+		[]string{"embedding", "half_embedding", "binary_embedding", "sparse_embedding"},
+		pgx.CopyFromSlice(1, func(i int) ([]any, error) {
+			return []interface{}{pgvector.NewVector([]float32{1, 2, 3}), pgvector.NewHalfVector([]float32{1, 2, 3}), pgvector.NewVector([]float32{1, -2, 3}).BinaryQuantize(), pgvector.NewSparseVector([]float32{1, 2, 3})}, nil
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	var quantized pgvector.BitVector
+	row = conn.QueryRow(ctx, "SELECT binary_quantize($1)::bit(3)", pgvector.NewVector([]float32{1, -2, 3}))
+	err = row.Scan(&quantized)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(quantized, pgvector.NewVector([]float32{1, -2, 3}).BinaryQuantize()) {
+		t.Error()
+	}
+
+	// the built-in codec is used by default
+	var bitValue any
+	err = conn.QueryRow(ctx, "SELECT '101'::bit(3)").Scan(&bitValue)
+	if err != nil {
+		panic(err)
+	}
+	if _, ok := bitValue.(pgtype.Bits); !ok {
+		t.Error()
+	}
+
+	bitConn, err := pgx.Connect(ctx, "postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+	defer bitConn.Close(ctx)
+
+	err = pgxvec.RegisterTypes(ctx, bitConn, pgxvec.WithBitVector())
 	if err != nil {
 		panic(err)
 	}
-	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
-		return pgxvec.RegisterTypes(ctx, conn)
+
+	err = bitConn.QueryRow(ctx, "SELECT '101'::bit(3)").Scan(&bitValue)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(bitValue, pgvector.NewBitVector([]bool{true, false, true})) {
+		t.Error()
+	}
+
+	var nullEmbedding pgvector.NullVector
+	var nullHalfEmbedding pgvector.NullHalfVector
+	var nullSparseEmbedding pgvector.NullSparseVector
+	row = conn.QueryRow(ctx, "SELECT $1::vector, $2::halfvec, $3::sparsevec", pgvector.NullVector{}, pgvector.NullHalfVector{}, pgvector.NullSparseVector{})
+	err = row.Scan(&nullEmbedding, &nullHalfEmbedding, &nullSparseEmbedding)
+	if err != nil {
+		panic(err)
+	}
+	if nullEmbedding.Valid || nullHalfEmbedding.Valid || nullSparseEmbedding.Valid {
+		t.Error()
+	}
+
+	row = conn.QueryRow(ctx, "SELECT $1::vector", pgvector.NullVector{Vector: pgvector.NewVector([]float32{1, 2, 3}), Valid: true})
+	err = row.Scan(&nullEmbedding)
+	if err != nil {
+		panic(err)
+	}
+	if !nullEmbedding.Valid || !reflect.DeepEqual(nullEmbedding.Vector.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	var float32s []float32
+	var float64s []float64
+	var halfFloat32s []float32
+	var elements map[int32]float32
+	row = conn.QueryRow(ctx, "SELECT $1::vector, $2::vector, $3::halfvec, '{1:1,3:2}/3'::sparsevec", []float32{1, 2, 3}, []float64{4, 5, 6}, []float32{1, 2, 3})
+	err = row.Scan(&float32s, &float64s, &halfFloat32s, &elements)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(float32s, []float32{1, 2, 3}) {
+		t.Error()
+	}
+	if !reflect.DeepEqual(float64s, []float64{4, 5, 6}) {
+		t.Error()
+	}
+	if !reflect.DeepEqual(halfFloat32s, []float32{1, 2, 3}) {
+		t.Error()
+	}
+	if !reflect.DeepEqual(elements, map[int32]float32{0: 1, 2: 2}) {
+		t.Error()
 	}
+
+	config, err := pgxpool.ParseConfig("postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+	pgxvec.RegisterTypesPool(config)
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		panic(err)
@@ -207,11 +301,16 @@ func TestPgx(t *testing.T) {
 		pgvector.NewSparseVector([]float32{1, 2, 3}),
 		pgvector.NewSparseVector([]float32{4, 5, 6}),
 	}
-	row = conn.QueryRow(ctx, "SELECT $1::vector[], $2::halfvec[], $3::sparsevec[]", embeddings, halfEmbeddings, sparseEmbeddings)
+	bitEmbeddings := []pgvector.BitVector{
+		pgvector.NewBitVector([]bool{true, false, true}),
+		pgvector.NewBitVector([]bool{false, true, false}),
+	}
+	row = conn.QueryRow(ctx, "SELECT $1::vector[], $2::halfvec[], $3::sparsevec[], $4::bit(3)[]", embeddings, halfEmbeddings, sparseEmbeddings, bitEmbeddings)
 	var scanEmbeddings []pgvector.Vector
 	var scanHalfEmbeddings []pgvector.HalfVector
 	var scanSparseEmbeddings []pgvector.SparseVector
-	err = row.Scan(&scanEmbeddings, &scanHalfEmbeddings, &scanSparseEmbeddings)
+	var scanBitEmbeddings []pgvector.BitVector
+	err = row.Scan(&scanEmbeddings, &scanHalfEmbeddings, &scanSparseEmbeddings, &scanBitEmbeddings)
 	if err != nil {
 		panic(err)
 	}
@@ -224,4 +323,228 @@ func TestPgx(t *testing.T) {
 	if !reflect.DeepEqual(scanSparseEmbeddings, sparseEmbeddings) {
 		t.Error()
 	}
+	if !reflect.DeepEqual(scanBitEmbeddings, bitEmbeddings) {
+		t.Error()
+	}
+
+	schemaConn, err := pgx.Connect(ctx, "postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+	defer schemaConn.Close(ctx)
+
+	err = pgxvec.RegisterTypes(ctx, schemaConn, pgxvec.WithSchemaDiscovery())
+	if err != nil {
+		panic(err)
+	}
+	if _, ok := schemaConn.TypeMap().TypeForName("public.vector"); !ok {
+		t.Error()
+	}
+
+	_, err = schemaConn.Exec(ctx, "DROP DOMAIN IF EXISTS pgx_embedding")
+	if err != nil {
+		panic(err)
+	}
+
+	_, err = schemaConn.Exec(ctx, "CREATE DOMAIN pgx_embedding AS vector(3)")
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgxvec.RegisterTypes(ctx, schemaConn, pgxvec.WithDomains())
+	if err != nil {
+		panic(err)
+	}
+
+	var domainEmbeddings []pgvector.Vector
+	err = schemaConn.QueryRow(ctx, "SELECT $1::pgx_embedding[]", embeddings).Scan(&domainEmbeddings)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(domainEmbeddings, embeddings) {
+		t.Error()
+	}
+
+	err = pgxvec.RegisterTypes(ctx, schemaConn, pgxvec.WithSchema("missing"))
+	if err == nil || err.Error() != "vector type not found in schema missing" {
+		t.Error()
+	}
+}
+
+func TestPgxSchema(t *testing.T) {
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, "postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE EXTENSION IF NOT EXISTS vector")
+	if err != nil {
+		panic(err)
+	}
+
+	// the extension is installed in public, so leave it off the search path
+	_, err = conn.Exec(ctx, "SET search_path TO pg_catalog")
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgxvec.RegisterTypes(ctx, conn)
+	if err == nil || err.Error() != "vector type not found in the database" {
+		t.Error()
+	}
+
+	for _, opt := range []pgxvec.Option{pgxvec.WithSchema("public"), pgxvec.WithSchemaDiscovery()} {
+		err = pgxvec.RegisterTypes(ctx, conn, opt)
+		if err != nil {
+			panic(err)
+		}
+
+		var embedding pgvector.Vector
+		var halfEmbedding pgvector.HalfVector
+		var sparseEmbedding pgvector.SparseVector
+		err = conn.QueryRow(ctx, "SELECT $1::public.vector, $2::public.halfvec, $3::public.sparsevec", pgvector.NewVector([]float32{1, 2, 3}), pgvector.NewHalfVector([]float32{1, 2, 3}), pgvector.NewSparseVector([]float32{1, 0, 3})).Scan(&embedding, &halfEmbedding, &sparseEmbedding)
+		if err != nil {
+			panic(err)
+		}
+		if !reflect.DeepEqual(embedding.Slice(), []float32{1, 2, 3}) {
+			t.Error()
+		}
+		if !reflect.DeepEqual(halfEmbedding.Slice(), []float32{1, 2, 3}) {
+			t.Error()
+		}
+		if !reflect.DeepEqual(sparseEmbedding.Slice(), []float32{1, 0, 3}) {
+			t.Error()
+		}
+
+		var distance float64
+		err = conn.QueryRow(ctx, "SELECT $1::public.vector OPERATOR(public.<->) $2::public.vector", pgvector.NewVector([]float32{1, 1, 1}), pgvector.NewVector([]float32{1, 1, 2})).Scan(&distance)
+		if err != nil {
+			panic(err)
+		}
+		if distance != 1 {
+			t.Error()
+		}
+	}
+}
+
+func TestPgxValidation(t *testing.T) {
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, "postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE EXTENSION IF NOT EXISTS vector")
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgxvec.RegisterTypes(ctx, conn, pgxvec.WithValidation())
+	if err != nil {
+		panic(err)
+	}
+
+	// sentinel errors are only returned by client-side validation
+	_, err = conn.Exec(ctx, "SELECT $1::vector", pgvector.NewVector([]float32{1, float32(math.NaN()), 3}))
+	if !errors.Is(err, pgvector.ErrNonFinite) {
+		t.Error()
+	}
+
+	_, err = conn.Exec(ctx, "SELECT $1::vector", pgvector.NewVector(make([]float32, 16001)))
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) {
+		t.Error()
+	}
+
+	_, err = conn.Exec(ctx, "SELECT $1::halfvec", pgvector.NewHalfVector([]float32{1, 70000}))
+	if !errors.Is(err, pgvector.ErrNonFinite) {
+		t.Error()
+	}
+
+	var embedding pgvector.Vector
+	err = conn.QueryRow(ctx, "SELECT $1::vector", pgvector.NewVector([]float32{1, 2, 3})).Scan(&embedding)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(embedding.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+}
+
+func TestPgxBufferReuse(t *testing.T) {
+	ctx := context.Background()
+
+	conn, err := pgx.Connect(ctx, "postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "CREATE EXTENSION IF NOT EXISTS vector")
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgxvec.RegisterTypes(ctx, conn, pgxvec.WithBufferReuse())
+	if err != nil {
+		panic(err)
+	}
+
+	rows, err := conn.Query(ctx, "SELECT ARRAY[i, i + 1, i + 2]::vector FROM generate_series(1, 3) i")
+	if err != nil {
+		panic(err)
+	}
+	defer rows.Close()
+
+	embedding := pgvector.NewVector(make([]float32, 3))
+	data := embedding.Slice()
+	for i := 1; rows.Next(); i++ {
+		err = rows.Scan(&embedding)
+		if err != nil {
+			panic(err)
+		}
+		if &embedding.Slice()[0] != &data[0] || !reflect.DeepEqual(embedding.Slice(), []float32{float32(i), float32(i + 1), float32(i + 2)}) {
+			t.Error()
+		}
+	}
+	if rows.Err() != nil {
+		panic(rows.Err())
+	}
+}
+
+func TestPgxStdlib(t *testing.T) {
+	config, err := pgx.ParseConfig("postgres://localhost/pgvector_go_test")
+	if err != nil {
+		panic(err)
+	}
+
+	db := stdlib.OpenDB(*config, pgxvec.OpenDBOption())
+	defer db.Close()
+
+	_, err = db.Exec("CREATE EXTENSION IF NOT EXISTS vector")
+	if err != nil {
+		panic(err)
+	}
+
+	var value any
+	err = db.QueryRow("SELECT $1::vector", pgvector.NewVector([]float32{1, 2, 3})).Scan(&value)
+	if err != nil {
+		panic(err)
+	}
+	if value != "[1,2,3]" {
+		t.Error()
+	}
+
+	var embedding pgvector.Vector
+	err = db.QueryRow("SELECT $1::vector", pgvector.NewVector([]float32{1, 2, 3})).Scan(&embedding)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(embedding.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
 }
@@ -1,8 +1,10 @@
 package pgvector_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"testing"
@@ -132,4 +134,523 @@ func TestSparseVectorParse(t *testing.T) {
 	if err == nil || !errors.Is(err, strconv.ErrRange) {
 		t.Error()
 	}
+
+	err = vec.Parse("{3:1,1:2}/6")
+	if !errors.Is(err, pgvector.ErrUnsortedIndices) || err.Error() != "sparsevec indices must be in ascending order" {
+		t.Error()
+	}
+
+	err = vec.Parse("{1:1,1:2}/6")
+	if !errors.Is(err, pgvector.ErrDuplicateIndex) || err.Error() != "sparsevec indices must not contain duplicates" {
+		t.Error()
+	}
+}
+
+func TestSparseVectorToDense(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{1, 0, 2, 0, 3, 0}).ToDense()
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorMarshal(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{1, 0, 2, 0, 3, 0})
+	data, err := json.Marshal(vec)
+	if err != nil {
+		panic(err)
+	}
+	if string(data) != `{"dimensions":6,"elements":{"0":1,"2":2,"4":3}}` {
+		t.Error()
+	}
+
+	data, err = json.Marshal(pgvector.SparseVectorIndicesValues{SparseVector: vec})
+	if err != nil {
+		panic(err)
+	}
+	if string(data) != `{"indices":[0,2,4],"values":[1,2,3],"dim":6}` {
+		t.Error()
+	}
+}
+
+func TestSparseVectorUnmarshal(t *testing.T) {
+	var vec pgvector.SparseVector
+	err := json.Unmarshal([]byte(`{"dimensions":6,"elements":{"4":3,"0":1,"2":2}}`), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte(`{"indices":[0,2,4],"values":[1,2,3],"dim":6}`), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	var wrapped pgvector.SparseVectorIndicesValues
+	err = json.Unmarshal([]byte(`{"indices":[0,2,4],"values":[1,2,3],"dim":6}`), &wrapped)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(wrapped.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte(`{"dimensions":6,"elements":{"6":1}}`), &vec)
+	if err == nil || err.Error() != "sparsevec index out of bounds" {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte(`{"indices":[0],"values":[],"dim":6}`), &vec)
+	if err == nil || err.Error() != "sparsevec indices and values must have the same length" {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte(`{}`), &vec)
+	if err == nil || err.Error() != "malformed sparsevec json" {
+		t.Error()
+	}
+
+	vec = pgvector.NewSparseVector([]float32{1, 0, 2})
+	err = vec.UnmarshalJSON([]byte("null"))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec, pgvector.SparseVector{}) {
+		t.Error()
+	}
+
+	dense := pgvector.NewVector([]float32{1, 0, 2})
+	err = dense.UnmarshalJSON([]byte("null"))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(dense, pgvector.Vector{}) {
+		t.Error()
+	}
+
+	half := pgvector.NewHalfVector([]float32{1, 0, 2})
+	err = half.UnmarshalJSON([]byte("null"))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(half, pgvector.HalfVector{}) {
+		t.Error()
+	}
+
+	var item struct{ Embedding pgvector.SparseVector }
+	err = json.Unmarshal([]byte(`{"Embedding":null}`), &item)
+	if err != nil {
+		panic(err)
+	}
+
+	err = json.Unmarshal([]byte(`{"indices":[0,1],"values":[0,2],"dim":3}`), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Indices(), []int32{1}) || !reflect.DeepEqual(vec.Values(), []float32{2}) || vec.Validate() != nil {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte(`{"dimensions":3,"elements":{"0":0,"1":2}}`), &vec)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Indices(), []int32{1}) || !reflect.DeepEqual(vec.Values(), []float32{2}) || vec.Validate() != nil {
+		t.Error()
+	}
+}
+
+func TestSparseVectorParseStrict(t *testing.T) {
+	var vec pgvector.SparseVector
+	err := vec.ParseStrict(" { 1:1, 3 : 2,5:3 } / 6 ")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{}/6")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{0, 0, 0, 0, 0, 0}) {
+		t.Error()
+	}
+
+	var parseErr *pgvector.ParseError
+	err = vec.ParseStrict("{1:1,7:2}/6")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 5 || parseErr.Index != 1 || parseErr.Msg != "index out of bounds" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{1:1,2}/6")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 6 || parseErr.Index != 1 {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{1:nan}/6")
+	if !errors.As(err, &parseErr) || parseErr.Msg != "NaN not allowed in sparsevec" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{}/0")
+	if !errors.As(err, &parseErr) || parseErr.Msg != "sparsevec must have at least 1 dimension" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1:1]/6")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 0 {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{5:3,1:1,3:2}/6")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{3:1,1:1,3:2}/6")
+	if !errors.As(err, &parseErr) || !errors.Is(err, pgvector.ErrDuplicateIndex) || parseErr.Offset != 9 || parseErr.Index != 2 {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{1:0,2:1}/3")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Indices(), []int32{1}) || !reflect.DeepEqual(vec.Values(), []float32{1}) || vec.Validate() != nil {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{1:0,2:1,5:2}/3")
+	if !errors.As(err, &parseErr) || !errors.Is(err, pgvector.ErrIndexOutOfBounds) || parseErr.Index != 2 {
+		t.Error()
+	}
+	ones := make([]float32, 16001)
+	for i := range ones {
+		ones[i] = 1
+	}
+	err = vec.ParseStrict(pgvector.NewSparseVector(ones[:16000]).String())
+	if err != nil {
+		panic(err)
+	}
+	err = vec.ParseStrict(pgvector.NewSparseVector(ones).String())
+	if !errors.Is(err, pgvector.ErrTooManyElements) || !errors.As(err, &parseErr) || parseErr.Index != 16000 {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("{}/1000000001")
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorValidate(t *testing.T) {
+	err := pgvector.NewSparseVector([]float32{1, 0, 2}).Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgvector.NewSparseVectorFromMap(map[int32]float32{}, 1000000001).Validate()
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) || err.Error() != "sparsevec cannot have more than 1000000000 dimensions" {
+		t.Error()
+	}
+
+	err = pgvector.NewSparseVector(make([]float32, 0)).Validate()
+	if !errors.Is(err, pgvector.ErrInvalidDimensions) {
+		t.Error()
+	}
+
+	elements := make([]float32, 16001)
+	for i := range elements {
+		elements[i] = 1
+	}
+	err = pgvector.NewSparseVector(elements).Validate()
+	if !errors.Is(err, pgvector.ErrTooManyElements) || err.Error() != "sparsevec cannot have more than 16000 non-zero elements" {
+		t.Error()
+	}
+
+	err = pgvector.NewSparseVectorFromMap(map[int32]float32{0: float32(math.NaN())}, 3).Validate()
+	if !errors.Is(err, pgvector.ErrNonFinite) {
+		t.Error()
+	}
+
+	var vec pgvector.SparseVector
+	err = vec.Parse("{1:0,2:1}/2")
+	if err != nil {
+		panic(err)
+	}
+	err = vec.Validate()
+	if !errors.Is(err, pgvector.ErrZeroValue) || err.Error() != "sparsevec values must not be zero" {
+		t.Error()
+	}
+}
+
+func TestSparseVectorDecodeBinaryInto(t *testing.T) {
+	buf, err := pgvector.NewSparseVector([]float32{1, 0, 2, 0, 3, 0}).EncodeBinary(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	vec := pgvector.NewSparseVector([]float32{4, 5, 6})
+	allocs := testing.AllocsPerRun(100, func() {
+		err := vec.DecodeBinaryInto(buf, vec.Indices(), vec.Values())
+		if err != nil {
+			panic(err)
+		}
+	})
+	if allocs != 0 {
+		t.Error()
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorParseInto(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{4, 5, 6})
+	allocs := testing.AllocsPerRun(100, func() {
+		err := vec.ParseInto("{1:1,3:2,5:3}/6", vec.Indices(), vec.Values())
+		if err != nil {
+			panic(err)
+		}
+	})
+	if allocs != 0 {
+		t.Error()
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	err := vec.ParseInto("{1:1,}/6", vec.Indices(), vec.Values())
+	if err == nil || err.Error() != "malformed sparsevec literal" {
+		t.Error()
+	}
+	if len(vec.Indices()) != 0 || len(vec.Values()) != 0 {
+		t.Error()
+	}
+
+	vec = pgvector.NewSparseVector([]float32{4, 5, 6})
+	err = vec.ParseInto("{2:1,1:1}/3", vec.Indices(), vec.Values())
+	if !errors.Is(err, pgvector.ErrUnsortedIndices) {
+		t.Error()
+	}
+	if vec.Dimensions() != 0 || len(vec.Indices()) != 0 || len(vec.Values()) != 0 {
+		t.Error()
+	}
+}
+
+func TestSparseVectorDecodeBinaryUnsorted(t *testing.T) {
+	buf := []byte{0, 0, 0, 6, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0x3f, 0x80, 0, 0, 0x40, 0, 0, 0}
+	var vec pgvector.SparseVector
+	err := vec.DecodeBinary(buf)
+	if !errors.Is(err, pgvector.ErrUnsortedIndices) {
+		t.Error()
+	}
+
+	vec = pgvector.NewSparseVector([]float32{4, 5, 6})
+	err = vec.DecodeBinaryInto(buf, vec.Indices(), vec.Values())
+	if !errors.Is(err, pgvector.ErrUnsortedIndices) {
+		t.Error()
+	}
+	if vec.Dimensions() != 0 || len(vec.Indices()) != 0 || len(vec.Values()) != 0 {
+		t.Error()
+	}
+}
+
+func TestSparseVectorCanonicalize(t *testing.T) {
+	indices := []int32{4, 0, 2, 0, 1, 3}
+	values := []float32{3, 1, 2, 2, 0, 1}
+
+	sum, err := pgvector.NewSparseVectorCanonical(indices, values, 6, pgvector.MergeSum)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sum.Indices(), []int32{0, 2, 3, 4}) || !reflect.DeepEqual(sum.Values(), []float32{3, 2, 1, 3}) {
+		t.Error()
+	}
+	if sum.Validate() != nil {
+		t.Error()
+	}
+
+	max, err := pgvector.NewSparseVectorCanonical(indices, values, 6, pgvector.MergeMax)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(max.Values(), []float32{2, 2, 1, 3}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorCanonical(indices, values, 6, pgvector.MergeError)
+	if !errors.Is(err, pgvector.ErrDuplicateIndex) {
+		t.Error()
+	}
+
+	sum, err = pgvector.NewSparseVectorCanonical([]int32{1, 1}, []float32{1, -1}, 3, pgvector.MergeSum)
+	if err != nil {
+		panic(err)
+	}
+	if len(sum.Indices()) != 0 || sum.Dimensions() != 3 {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorCanonical([]int32{3}, []float32{1}, 3, pgvector.MergeSum)
+	if !errors.Is(err, pgvector.ErrIndexOutOfBounds) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorCanonical([]int32{1}, []float32{}, 3, pgvector.MergeSum)
+	if !errors.Is(err, pgvector.ErrInvalidLength) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorUnmarshalJSONUnsorted(t *testing.T) {
+	var vec pgvector.SparseVector
+	err := json.Unmarshal([]byte(`{"indices":[2,0,2],"values":[1,1,5],"dim":3}`), &vec)
+	if !errors.Is(err, pgvector.ErrUnsortedIndices) {
+		t.Error()
+	}
+
+	err = json.Unmarshal([]byte(`{"indices":[0,0],"values":[1,5],"dim":3}`), &vec)
+	if !errors.Is(err, pgvector.ErrDuplicateIndex) {
+		t.Error()
+	}
+}
+
+func TestNewSparseVectorFromMapE(t *testing.T) {
+	vec, err := pgvector.NewSparseVectorFromMapE(map[int32]float32{2: 2, 4: 3, 3: 0, 0: 1}, 6)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorFromMapE(map[int32]float32{6: 1}, 6)
+	if !errors.Is(err, pgvector.ErrIndexOutOfBounds) || err.Error() != "sparsevec index out of bounds" {
+		t.Error()
+	}
+}
+
+func TestNewSparseVectorFromPairs(t *testing.T) {
+	vec, err := pgvector.NewSparseVectorFromPairs([]int32{0, 2, 4}, []float32{1, 2, 3}, 6)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 0, 2, 0, 3, 0}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorFromPairs([]int32{0, 2}, []float32{1}, 6)
+	if !errors.Is(err, pgvector.ErrInvalidLength) || err.Error() != "sparsevec indices and values must have the same length" {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorFromPairs([]int32{2, 0}, []float32{1, 2}, 6)
+	if !errors.Is(err, pgvector.ErrUnsortedIndices) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorFromPairs([]int32{0, 6}, []float32{1, 2}, 6)
+	if !errors.Is(err, pgvector.ErrIndexOutOfBounds) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVectorFromPairs([]int32{0, 1}, []float32{0, 1}, 2)
+	if !errors.Is(err, pgvector.ErrZeroValue) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorDot(t *testing.T) {
+	dot, err := pgvector.NewSparseVector([]float32{1, 0, 3}).Dot(pgvector.NewSparseVector([]float32{0, 2, 3}))
+	if err != nil {
+		panic(err)
+	}
+	if dot != 9 {
+		t.Error()
+	}
+}
+
+func TestSparseVectorAdd(t *testing.T) {
+	vec, err := pgvector.NewSparseVector([]float32{1, 0, 3, 0}).Add(pgvector.NewSparseVector([]float32{0, 2, -3, 0}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Indices(), []int32{0, 1}) || !reflect.DeepEqual(vec.Values(), []float32{1, 2}) || vec.Dimensions() != 4 {
+		t.Error()
+	}
+
+	_, err = pgvector.NewSparseVector([]float32{1, 2, 3}).Add(pgvector.NewSparseVector([]float32{1, 2}))
+	if err == nil || err.Error() != "different sparsevec dimensions 3 and 2" {
+		t.Error()
+	}
+}
+
+func TestSparseVectorScale(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{1, 0, 3}).Scale(2)
+	if !reflect.DeepEqual(vec.Slice(), []float32{2, 0, 6}) {
+		t.Error()
+	}
+
+	vec = pgvector.NewSparseVector([]float32{1, 0, 3}).Scale(0)
+	if len(vec.Indices()) != 0 {
+		t.Error()
+	}
+}
+
+func TestSparseVectorNorm(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{3, 0, 4})
+	if vec.Norm() != 5 {
+		t.Error()
+	}
+}
+
+func TestSparseVectorNormalize(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{3, 0, 4}).Normalize()
+	if !reflect.DeepEqual(vec.Slice(), []float32{0.6, 0, 0.8}) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorTopK(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{1, -4, 0, 3, 2, 3})
+	top := vec.TopK(3)
+	if !reflect.DeepEqual(top.Indices(), []int32{1, 3, 5}) || !reflect.DeepEqual(top.Values(), []float32{-4, 3, 3}) {
+		t.Error()
+	}
+
+	top = vec.TopK(2)
+	if !reflect.DeepEqual(top.Indices(), []int32{1, 3}) {
+		t.Error()
+	}
+
+	top = vec.TopK(10)
+	if !reflect.DeepEqual(top.Indices(), vec.Indices()) {
+		t.Error()
+	}
+
+	top = vec.TopK(0)
+	if len(top.Indices()) != 0 || top.Dimensions() != 6 {
+		t.Error()
+	}
+
+	if !reflect.DeepEqual(vec.Values(), []float32{1, -4, 3, 2, 3}) {
+		t.Error()
+	}
+}
+
+func TestSparseVectorThreshold(t *testing.T) {
+	vec := pgvector.NewSparseVector([]float32{0.1, -0.5, 0, 0.2, 1}).Threshold(0.2)
+	if !reflect.DeepEqual(vec.Indices(), []int32{1, 4}) || !reflect.DeepEqual(vec.Values(), []float32{-0.5, 1}) {
+		t.Error()
+	}
 }
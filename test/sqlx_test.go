@@ -13,7 +13,7 @@ type SqlxItem struct {
 	Id              int64
 	Embedding       pgvector.Vector
 	HalfEmbedding   pgvector.HalfVector
-	BinaryEmbedding string
+	BinaryEmbedding pgvector.BitVector
 	SparseEmbedding pgvector.SparseVector
 }
 
@@ -22,19 +22,19 @@ func CreateSqlxItems(db *sqlx.DB) {
 		SqlxItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 1}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 1}),
-			BinaryEmbedding: "000",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{false, false, false}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 1}),
 		},
 		SqlxItem{
 			Embedding:       pgvector.NewVector([]float32{2, 2, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{2, 2, 2}),
-			BinaryEmbedding: "101",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, false, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{2, 2, 2}),
 		},
 		SqlxItem{
 			Embedding:       pgvector.NewVector([]float32{1, 1, 2}),
 			HalfEmbedding:   pgvector.NewHalfVector([]float32{1, 1, 2}),
-			BinaryEmbedding: "111",
+			BinaryEmbedding: pgvector.NewBitVector([]bool{true, true, true}),
 			SparseEmbedding: pgvector.NewSparseVector([]float32{1, 1, 2}),
 		},
 	}
@@ -71,7 +71,7 @@ func TestSqlx(t *testing.T) {
 	if !reflect.DeepEqual(items[1].HalfEmbedding.Slice(), []float32{1, 1, 2}) {
 		t.Error()
 	}
-	if items[0].BinaryEmbedding != "000" || items[1].BinaryEmbedding != "111" || items[2].BinaryEmbedding != "101" {
+	if items[0].BinaryEmbedding.String() != "000" || items[1].BinaryEmbedding.String() != "111" || items[2].BinaryEmbedding.String() != "101" {
 		t.Error()
 	}
 	if !reflect.DeepEqual(items[1].SparseEmbedding.Slice(), []float32{1, 1, 2}) {
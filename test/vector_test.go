@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
 	"testing"
@@ -80,3 +81,386 @@ func TestVectorUnmarshal(t *testing.T) {
 		t.Error()
 	}
 }
+
+func TestVectorAdd(t *testing.T) {
+	vec, err := pgvector.NewVector([]float32{1, 2, 3}).Add(pgvector.NewVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{5, 7, 9}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewVector([]float32{1, 2, 3}).Add(pgvector.NewVector([]float32{1, 2}))
+	if err == nil || err.Error() != "different vector dimensions 3 and 2" {
+		t.Error()
+	}
+}
+
+func TestVectorSub(t *testing.T) {
+	vec, err := pgvector.NewVector([]float32{1, 2, 3}).Sub(pgvector.NewVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{-3, -3, -3}) {
+		t.Error()
+	}
+}
+
+func TestVectorMul(t *testing.T) {
+	vec, err := pgvector.NewVector([]float32{1, 2, 3}).Mul(pgvector.NewVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{4, 10, 18}) {
+		t.Error()
+	}
+}
+
+func TestVectorScale(t *testing.T) {
+	vec := pgvector.NewVector([]float32{1, 2, 3}).Scale(2)
+	if !reflect.DeepEqual(vec.Slice(), []float32{2, 4, 6}) {
+		t.Error()
+	}
+}
+
+func TestVectorDot(t *testing.T) {
+	dot, err := pgvector.NewVector([]float32{1, 2, 3}).Dot(pgvector.NewVector([]float32{4, 5, 6}))
+	if err != nil {
+		panic(err)
+	}
+	if dot != 32 {
+		t.Error()
+	}
+}
+
+func TestVectorNorm(t *testing.T) {
+	vec := pgvector.NewVector([]float32{3, 4})
+	if vec.Norm() != 5 {
+		t.Error()
+	}
+}
+
+func TestVectorNormalize(t *testing.T) {
+	vec := pgvector.NewVector([]float32{3, 4}).Normalize()
+	if !reflect.DeepEqual(vec.Slice(), []float32{0.6, 0.8}) {
+		t.Error()
+	}
+
+	vec = pgvector.NewVector([]float32{0, 0}).Normalize()
+	if !reflect.DeepEqual(vec.Slice(), []float32{0, 0}) {
+		t.Error()
+	}
+}
+
+func TestVectorSubvector(t *testing.T) {
+	vec := pgvector.NewVector([]float32{1, 2, 3, 4, 5})
+	sub, err := vec.Subvector(2, 3)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sub.Slice(), []float32{2, 3, 4}) {
+		t.Error()
+	}
+
+	sub, err = vec.Subvector(3, 9)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sub.Slice(), []float32{3, 4, 5}) {
+		t.Error()
+	}
+
+	sub, err = vec.Subvector(-1, 3)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(sub.Slice(), []float32{1}) {
+		t.Error()
+	}
+
+	_, err = vec.Subvector(1, 0)
+	if err == nil || err.Error() != "vector must have at least 1 dimension" {
+		t.Error()
+	}
+
+	_, err = vec.Subvector(6, 1)
+	if err == nil || err.Error() != "vector must have at least 1 dimension" {
+		t.Error()
+	}
+
+	_, err = vec.Subvector(-5, 3)
+	if err == nil || err.Error() != "vector must have at least 1 dimension" {
+		t.Error()
+	}
+}
+
+func TestVectorConcat(t *testing.T) {
+	vec, err := pgvector.NewVector([]float32{1, 2}).Concat(pgvector.NewVector([]float32{3}))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	_, err = pgvector.NewVector(make([]float32, 16000)).Concat(pgvector.NewVector([]float32{1}))
+	if err == nil || err.Error() != "vector cannot have more than 16000 dimensions" {
+		t.Error()
+	}
+}
+
+func TestVectorTruncate(t *testing.T) {
+	vec, err := pgvector.NewVector([]float32{3, 4, 5}).Truncate(2)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{0.6, 0.8}) {
+		t.Error()
+	}
+}
+
+func TestVectorBinaryQuantize(t *testing.T) {
+	vec := pgvector.NewVector([]float32{1, -2, 0, 3, 4, 5, 6, 7, 8}).BinaryQuantize()
+	if fmt.Sprint(vec) != "100111111" {
+		t.Error()
+	}
+}
+
+func TestVectorToHalf(t *testing.T) {
+	vec := pgvector.NewVector([]float32{1, 1.0001, 70000}).ToHalf()
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 1, float32(math.Inf(1))}) {
+		t.Error()
+	}
+}
+
+func TestVectorToHalfChecked(t *testing.T) {
+	vec, err := pgvector.NewVector([]float32{1, 2, 3}).ToHalfChecked()
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	var conversionErr *pgvector.ConversionError
+	_, err = pgvector.NewVector([]float32{1, 70000}).ToHalfChecked()
+	if !errors.As(err, &conversionErr) || conversionErr.Index != 1 || err.Error() != "cannot convert element 1 to halfvec: value out of range: overflow" {
+		t.Error()
+	}
+
+	_, err = pgvector.NewVector([]float32{1e-10}).ToHalfChecked()
+	if !errors.As(err, &conversionErr) || conversionErr.Index != 0 || err.Error() != "cannot convert element 0 to halfvec: value out of range: underflow" {
+		t.Error()
+	}
+}
+
+func TestVectorToSparse(t *testing.T) {
+	vec := pgvector.NewVector([]float32{1, 0, 2}).ToSparse()
+	if fmt.Sprint(vec) != "{1:1,3:2}/3" {
+		t.Error()
+	}
+}
+
+func TestVectorToSparseChecked(t *testing.T) {
+	_, err := pgvector.NewVector(make([]float32, 20000)).ToSparseChecked()
+	if err != nil {
+		panic(err)
+	}
+
+	slice := make([]float32, 20000)
+	for i := range slice {
+		slice[i] = 1
+	}
+	var conversionErr *pgvector.ConversionError
+	_, err = pgvector.NewVector(slice).ToSparseChecked()
+	if !errors.As(err, &conversionErr) || err.Error() != "cannot convert to sparsevec: sparsevec cannot have more than 16000 non-zero elements" {
+		t.Error()
+	}
+}
+
+func TestVectorParseStrict(t *testing.T) {
+	var vec pgvector.Vector
+	err := vec.ParseStrict(" [ 1, 2 ,3 ] ")
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	var parseErr *pgvector.ParseError
+	err = vec.ParseStrict("(1,2,3)")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 0 || parseErr.Index != -1 || err.Error() != `malformed vector literal at offset 0: expected '[', found '('` {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1,a,3]")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 3 || parseErr.Index != 1 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1,2,NaN]")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 5 || parseErr.Index != 2 || parseErr.Msg != "NaN not allowed in vector" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1,Infinity]")
+	if !errors.As(err, &parseErr) || parseErr.Index != 1 || parseErr.Msg != "infinite value not allowed in vector" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[4e38]")
+	if !errors.As(err, &parseErr) || !errors.Is(err, strconv.ErrRange) {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[]")
+	if !errors.As(err, &parseErr) || parseErr.Msg != "vector must have at least 1 dimension" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1,2")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 4 || parseErr.Msg != "unexpected end of input" {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1 2]")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 3 || parseErr.Index != 0 {
+		t.Error()
+	}
+
+	err = vec.ParseStrict("[1,2]x")
+	if !errors.As(err, &parseErr) || parseErr.Offset != 5 || parseErr.Msg != "junk after end of literal" {
+		t.Error()
+	}
+	ones := make([]float32, 16001)
+	for i := range ones {
+		ones[i] = 1
+	}
+	err = vec.ParseStrict(pgvector.NewVector(ones[:16000]).String())
+	if err != nil {
+		panic(err)
+	}
+	err = vec.ParseStrict(pgvector.NewVector(ones).String())
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) || !errors.As(err, &parseErr) || parseErr.Index != 16000 {
+		t.Error()
+	}
+}
+
+func TestVectorValidate(t *testing.T) {
+	err := pgvector.NewVector([]float32{1, 2, 3}).Validate()
+	if err != nil {
+		panic(err)
+	}
+
+	err = pgvector.NewVector([]float32{}).Validate()
+	if !errors.Is(err, pgvector.ErrInvalidDimensions) || err.Error() != "vector must have at least 1 dimension" {
+		t.Error()
+	}
+
+	err = pgvector.NewVector(make([]float32, 16001)).Validate()
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) || err.Error() != "vector cannot have more than 16000 dimensions" {
+		t.Error()
+	}
+
+	err = pgvector.NewVector([]float32{1, float32(math.NaN())}).Validate()
+	if !errors.Is(err, pgvector.ErrNonFinite) || err.Error() != "NaN not allowed in vector" {
+		t.Error()
+	}
+
+	err = pgvector.NewVector([]float32{1, float32(math.Inf(-1))}).Validate()
+	if !errors.Is(err, pgvector.ErrNonFinite) || err.Error() != "infinite value not allowed in vector" {
+		t.Error()
+	}
+}
+
+func TestVectorValidated(t *testing.T) {
+	value, err := pgvector.Validated(pgvector.NewVector([]float32{1, 2, 3})).Value()
+	if err != nil {
+		panic(err)
+	}
+	if value != "[1,2,3]" {
+		t.Error()
+	}
+
+	_, err = pgvector.Validated(pgvector.NewVector([]float32{})).Value()
+	if !errors.Is(err, pgvector.ErrInvalidDimensions) {
+		t.Error()
+	}
+}
+
+func TestVectorEncodeBinaryTooManyDimensions(t *testing.T) {
+	_, err := pgvector.NewVector(make([]float32, 65536)).EncodeBinary(nil)
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) || err.Error() != "vector cannot have more than 65535 dimensions" {
+		t.Error()
+	}
+}
+
+func TestVectorDecodeBinaryInto(t *testing.T) {
+	buf, err := pgvector.NewVector([]float32{1, 2, 3}).EncodeBinary(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	dst := make([]float32, 0, 3)
+	var vec pgvector.Vector
+	err = vec.DecodeBinaryInto(buf, dst)
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) || &vec.Slice()[0] != &dst[:1][0] {
+		t.Error()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		err := vec.DecodeBinaryInto(buf, vec.Slice())
+		if err != nil {
+			panic(err)
+		}
+	})
+	if allocs != 0 {
+		t.Error()
+	}
+}
+
+func TestVectorParseInto(t *testing.T) {
+	var vec pgvector.Vector
+	err := vec.ParseInto("[1,2,3]", make([]float32, 0, 3))
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{1, 2, 3}) {
+		t.Error()
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		err := vec.ParseInto("[4,5,6]", vec.Slice())
+		if err != nil {
+			panic(err)
+		}
+	})
+	if allocs != 0 {
+		t.Error()
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{4, 5, 6}) {
+		t.Error()
+	}
+
+	err = vec.ParseInto("[1,,2]", vec.Slice())
+	if err == nil || !errors.Is(err, strconv.ErrSyntax) {
+		t.Error()
+	}
+	if len(vec.Slice()) != 0 {
+		t.Error()
+	}
+
+	vec = pgvector.NewVector([]float32{4, 5, 6})
+	err = vec.ParseInto("[1,x]", vec.Slice())
+	if err == nil {
+		t.Error()
+	}
+	if len(vec.Slice()) != 0 {
+		t.Error()
+	}
+}
@@ -0,0 +1,129 @@
+package pgvector_test
+
+import (
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/pgvector/pgvector-go"
+)
+
+func TestLoadSparseVocabulary(t *testing.T) {
+	vocab, err := pgvector.LoadSparseVocabulary(strings.NewReader("[PAD]\r\nthe\r\ndog\r\ncat\r\n"))
+	if err != nil {
+		panic(err)
+	}
+	if vocab.Dimensions() != 4 {
+		t.Error()
+	}
+	index, ok := vocab.Index("dog")
+	if !ok || index != 2 {
+		t.Error()
+	}
+	token, ok := vocab.Token(3)
+	if !ok || token != "cat" {
+		t.Error()
+	}
+	_, ok = vocab.Token(4)
+	if ok {
+		t.Error()
+	}
+}
+
+func TestLoadSparseVocabularyJSON(t *testing.T) {
+	vocab, err := pgvector.LoadSparseVocabularyJSON(strings.NewReader(`{"[PAD]":0,"the":1,"dog":2,"cat":4}`))
+	if err != nil {
+		panic(err)
+	}
+	if vocab.Dimensions() != 5 {
+		t.Error()
+	}
+	index, ok := vocab.Index("cat")
+	if !ok || index != 4 {
+		t.Error()
+	}
+
+	token, ok := vocab.Token(3)
+	if !ok || token != "" {
+		t.Error()
+	}
+
+	_, err = pgvector.LoadSparseVocabularyJSON(strings.NewReader(`{"the":1,"dog":1}`))
+	if !errors.Is(err, pgvector.ErrDuplicateIndex) {
+		t.Error()
+	}
+
+	_, err = pgvector.LoadSparseVocabularyJSON(strings.NewReader(`{"":1,"dog":1}`))
+	if !errors.Is(err, pgvector.ErrDuplicateIndex) {
+		t.Error()
+	}
+
+	_, err = pgvector.LoadSparseVocabularyJSON(strings.NewReader(`{"x":2000000000}`))
+	if !errors.Is(err, pgvector.ErrTooManyDimensions) {
+		t.Error()
+	}
+}
+
+func TestLoadSparseVocabularyJSONLargeIndex(t *testing.T) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	vocab, err := pgvector.LoadSparseVocabularyJSON(strings.NewReader(`{"x":999999999}`))
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		panic(err)
+	}
+	if vocab.Dimensions() != 1000000000 {
+		t.Error()
+	}
+	// memory should not depend on the largest index
+	if after.TotalAlloc-before.TotalAlloc > 1<<20 {
+		t.Error()
+	}
+}
+
+func TestSparseVocabularySparseVector(t *testing.T) {
+	vocab, err := pgvector.NewSparseVocabulary([]string{"[PAD]", "the", "dog", "cat"})
+	if err != nil {
+		panic(err)
+	}
+
+	vec, err := vocab.SparseVector(map[string]float32{"cat": 0.5, "dog": 1.5, "the": 0})
+	if err != nil {
+		panic(err)
+	}
+	if !reflect.DeepEqual(vec.Slice(), []float32{0, 0, 1.5, 0.5}) {
+		t.Error()
+	}
+
+	_, err = vocab.SparseVector(map[string]float32{"bear": 1})
+	if !errors.Is(err, pgvector.ErrUnknownToken) || err.Error() != `unknown token "bear"` {
+		t.Error()
+	}
+}
+
+func TestSparseVocabularyExplain(t *testing.T) {
+	vocab, err := pgvector.NewSparseVocabulary([]string{"[PAD]", "the", "dog", "cat"})
+	if err != nil {
+		panic(err)
+	}
+
+	explanation, err := vocab.Explain(pgvector.NewSparseVector([]float32{0, 0.1, 1.5, -2}))
+	if err != nil {
+		panic(err)
+	}
+	expected := []pgvector.TokenWeight{
+		{Index: 3, Token: "cat", Weight: -2},
+		{Index: 2, Token: "dog", Weight: 1.5},
+		{Index: 1, Token: "the", Weight: 0.1},
+	}
+	if !reflect.DeepEqual(explanation, expected) {
+		t.Error()
+	}
+
+	_, err = vocab.Explain(pgvector.NewSparseVector([]float32{1, 2}))
+	if !errors.Is(err, pgvector.ErrDimensionMismatch) {
+		t.Error()
+	}
+}
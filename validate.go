@@ -0,0 +1,70 @@
+package pgvector
+
+import (
+	"database/sql/driver"
+	"math"
+)
+
+// Limits enforced by the server.
+const (
+	// MaxVectorDimensions is the maximum number of dimensions for vector.
+	MaxVectorDimensions = 16000
+	// MaxHalfVectorDimensions is the maximum number of dimensions for halfvec.
+	MaxHalfVectorDimensions = 16000
+	// MaxSparseVectorDimensions is the maximum number of dimensions for sparsevec.
+	MaxSparseVectorDimensions = 1000000000
+	// MaxSparseVectorNonZero is the maximum number of non-zero elements for sparsevec.
+	MaxSparseVectorNonZero = 16000
+)
+
+func maxDenseDimensions(name string) int {
+	if name == "halfvec" {
+		return MaxHalfVectorDimensions
+	}
+	return MaxVectorDimensions
+}
+
+func checkDim(name string, dim int, max int) error {
+	if dim < 1 {
+		return newError(ErrInvalidDimensions, "%s must have at least 1 dimension", name)
+	}
+	if dim > max {
+		return newError(ErrTooManyDimensions, "%s cannot have more than %d dimensions", name, max)
+	}
+	return nil
+}
+
+func checkElements(name string, vec []float32) error {
+	for _, v := range vec {
+		if math.IsNaN(float64(v)) {
+			return newError(ErrNonFinite, "NaN not allowed in %s", name)
+		}
+		if math.IsInf(float64(v), 0) {
+			return newError(ErrNonFinite, "infinite value not allowed in %s", name)
+		}
+	}
+	return nil
+}
+
+type validator interface {
+	driver.Valuer
+	Validate() error
+}
+
+// Validated wraps a value so that Value returns an error if the value would be rejected by the server.
+func Validated(v validator) driver.Valuer {
+	return validatedValuer{v: v}
+}
+
+type validatedValuer struct {
+	v validator
+}
+
+// Value implements the driver.Valuer interface.
+func (v validatedValuer) Value() (driver.Value, error) {
+	err := v.v.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return v.v.Value()
+}
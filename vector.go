@@ -27,6 +27,21 @@ func (v Vector) Slice() []float32 {
 	return v.vec
 }
 
+// Dimensions returns the number of dimensions.
+func (v Vector) Dimensions() int32 {
+	return int32(len(v.vec))
+}
+
+// Type returns the SQL type name.
+func (v Vector) Type() string {
+	return "vector"
+}
+
+// OpClass returns the operator class for an index with a distance metric, like vector_l2_ops.
+func (v Vector) OpClass(metric Metric) (string, error) {
+	return opClass("vector", metric)
+}
+
 // String returns a string representation of the vector.
 func (v Vector) String() string {
 	buf := make([]byte, 0, 2+16*len(v.vec))
@@ -45,31 +60,68 @@ func (v Vector) String() string {
 
 // Parse parses a string representation of a vector.
 func (v *Vector) Parse(s string) error {
+	return v.ParseInto(s, nil)
+}
+
+// ParseInto parses a string representation of a vector, storing the elements in dst if it has enough capacity.
+// Pass v.Slice() to reuse the existing slice.
+func (v *Vector) ParseInto(s string, dst []float32) error {
 	// TODO check brackets in 0.5.0
 	if len(s) < 2 {
-		return fmt.Errorf("malformed vector literal")
+		return newError(ErrMalformed, "malformed vector literal")
 	}
 
 	if len(s) == 2 {
-		v.vec = []float32{}
+		v.vec = reuseSlice(dst, 0)
 		return nil
 	}
 
-	sp := strings.Split(s[1:len(s)-1], ",")
-	v.vec = make([]float32, 0, len(sp))
-	for i := 0; i < len(sp); i++ {
-		n, err := strconv.ParseFloat(sp[i], 32)
+	s = s[1 : len(s)-1]
+	vec := reuseSlice(dst, strings.Count(s, ",")+1)
+	for {
+		e, rest, found := strings.Cut(s, ",")
+		n, err := strconv.ParseFloat(e, 32)
 		if err != nil {
-			return err
+			// dst may be the backing array of v, so do not leave it partially overwritten
+			v.vec = vec[:0]
+			return wrapNumError(err)
+		}
+		vec = append(vec, float32(n))
+		if !found {
+			break
 		}
-		v.vec = append(v.vec, float32(n))
+		s = rest
 	}
+	v.vec = vec
 	return nil
 }
 
+// ParseStrict parses a string representation of a vector using the same rules as the server.
+// It returns a *ParseError if the string is malformed.
+func (v *Vector) ParseStrict(s string) error {
+	vec, err := parseDenseStrict("vector", s)
+	if err != nil {
+		return err
+	}
+	v.vec = vec
+	return nil
+}
+
+// Validate returns an error if the vector would be rejected by the server.
+func (v Vector) Validate() error {
+	err := checkDim("vector", len(v.vec), MaxVectorDimensions)
+	if err != nil {
+		return err
+	}
+	return checkElements("vector", v.vec)
+}
+
 // EncodeBinary encodes a binary representation of the vector.
 func (v Vector) EncodeBinary(buf []byte) (newBuf []byte, err error) {
 	dim := len(v.vec)
+	if dim > math.MaxUint16 {
+		return nil, newError(ErrTooManyDimensions, "vector cannot have more than %d dimensions", math.MaxUint16)
+	}
 	buf = slices.Grow(buf, 4+4*dim)
 	buf = binary.BigEndian.AppendUint16(buf, uint16(dim))
 	buf = binary.BigEndian.AppendUint16(buf, 0)
@@ -81,25 +133,31 @@ func (v Vector) EncodeBinary(buf []byte) (newBuf []byte, err error) {
 
 // DecodeBinary decodes a binary representation of a vector.
 func (v *Vector) DecodeBinary(buf []byte) error {
+	return v.DecodeBinaryInto(buf, nil)
+}
+
+// DecodeBinaryInto decodes a binary representation of a vector, storing the elements in dst if it has enough capacity.
+// Pass v.Slice() to reuse the existing slice.
+func (v *Vector) DecodeBinaryInto(buf []byte, dst []float32) error {
 	if len(buf) < 4 {
-		return fmt.Errorf("invalid length")
+		return newError(ErrInvalidLength, "invalid length")
 	}
 
 	dim := int(binary.BigEndian.Uint16(buf[0:2]))
 	if dim < 0 {
-		return fmt.Errorf("vector cannot have negative dimensions")
+		return newError(ErrInvalidDimensions, "vector cannot have negative dimensions")
 	}
 
 	unused := binary.BigEndian.Uint16(buf[2:4])
 	if unused != 0 {
-		return fmt.Errorf("expected unused to be 0")
+		return newError(ErrInvalidLength, "expected unused to be 0")
 	}
 
 	if (len(buf)-4)/4 != dim || len(buf)%4 != 0 {
-		return fmt.Errorf("invalid length")
+		return newError(ErrInvalidLength, "invalid length")
 	}
 
-	v.vec = make([]float32, 0, dim)
+	v.vec = reuseSlice(dst, dim)
 	offset := 4
 	for i := 0; i < dim; i++ {
 		v.vec = append(v.vec, math.Float32frombits(binary.BigEndian.Uint32(buf[offset:offset+4])))
@@ -108,6 +166,99 @@ func (v *Vector) DecodeBinary(buf []byte) error {
 	return nil
 }
 
+// Add returns the elementwise sum of two vectors.
+func (v Vector) Add(o Vector) (Vector, error) {
+	vec, err := elementwise("vector", v.vec, o.vec, add)
+	return Vector{vec: vec}, err
+}
+
+// Sub returns the elementwise difference of two vectors.
+func (v Vector) Sub(o Vector) (Vector, error) {
+	vec, err := elementwise("vector", v.vec, o.vec, sub)
+	return Vector{vec: vec}, err
+}
+
+// Mul returns the elementwise product of two vectors.
+func (v Vector) Mul(o Vector) (Vector, error) {
+	vec, err := elementwise("vector", v.vec, o.vec, mul)
+	return Vector{vec: vec}, err
+}
+
+// Scale returns the vector multiplied by a scalar.
+func (v Vector) Scale(s float32) Vector {
+	return Vector{vec: scale(v.vec, s)}
+}
+
+// Dot returns the inner product of two vectors.
+func (v Vector) Dot(o Vector) (float64, error) {
+	return InnerProduct(v, o)
+}
+
+// Norm returns the Euclidean norm of the vector (like l2_norm).
+func (v Vector) Norm() float64 {
+	return norm(v.vec)
+}
+
+// Normalize returns the vector with a Euclidean norm of 1 (like l2_normalize).
+func (v Vector) Normalize() Vector {
+	return Vector{vec: normalize(v.vec)}
+}
+
+// Subvector returns count elements starting at a 1-based index (like subvector).
+func (v Vector) Subvector(start int, count int) (Vector, error) {
+	vec, err := subvector("vector", v.vec, start, count)
+	return Vector{vec: vec}, err
+}
+
+// Concat returns the concatenation of two vectors (like the || operator).
+func (v Vector) Concat(o Vector) (Vector, error) {
+	vec, err := concat("vector", v.vec, o.vec)
+	return Vector{vec: vec}, err
+}
+
+// Truncate returns the first dims elements, normalized (like l2_normalize(subvector(v, 1, dims))).
+func (v Vector) Truncate(dims int) (Vector, error) {
+	sub, err := v.Subvector(1, dims)
+	if err != nil {
+		return Vector{}, err
+	}
+	return sub.Normalize(), nil
+}
+
+// BinaryQuantize returns a bit vector with bits set for positive elements (like binary_quantize).
+func (v Vector) BinaryQuantize() BitVector {
+	return binaryQuantize(v.vec)
+}
+
+// ToHalf converts the vector to a half vector, rounding to the nearest float16.
+func (v Vector) ToHalf() HalfVector {
+	vec, _ := toHalf(v.vec, false)
+	return HalfVector{vec: vec}
+}
+
+// ToHalfChecked converts the vector to a half vector and returns a *ConversionError if an element overflows or underflows float16.
+func (v Vector) ToHalfChecked() (HalfVector, error) {
+	vec, err := toHalf(v.vec, true)
+	if err != nil {
+		return HalfVector{}, err
+	}
+	return HalfVector{vec: vec}, nil
+}
+
+// ToSparse converts the vector to a sparse vector.
+func (v Vector) ToSparse() SparseVector {
+	return NewSparseVector(v.vec)
+}
+
+// ToSparseChecked converts the vector to a sparse vector and returns a *ConversionError if it has too many non-zero elements.
+func (v Vector) ToSparseChecked() (SparseVector, error) {
+	sv := NewSparseVector(v.vec)
+	if len(sv.indices) > MaxSparseVectorNonZero {
+		return SparseVector{}, &ConversionError{Type: "sparsevec", Index: -1, Reason: fmt.Sprintf("sparsevec cannot have more than %d non-zero elements", MaxSparseVectorNonZero), kind: ErrTooManyElements}
+	}
+	return sv, nil
+}
+
 // statically assert that Vector implements sql.Scanner.
 var _ sql.Scanner = (*Vector)(nil)
 
@@ -119,7 +270,7 @@ func (v *Vector) Scan(src interface{}) (err error) {
 	case string:
 		return v.Parse(src)
 	default:
-		return fmt.Errorf("unsupported data type: %T", src)
+		return newError(ErrUnsupportedType, "unsupported data type: %T", src)
 	}
 }
 
@@ -146,3 +297,11 @@ var _ json.Unmarshaler = (*Vector)(nil)
 func (v *Vector) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &v.vec)
 }
+
+// reuseSlice returns dst with a length of 0 if it has capacity for n elements, or a new slice otherwise.
+func reuseSlice[T any](dst []T, n int) []T {
+	if dst == nil || cap(dst) < n {
+		return make([]T, 0, n)
+	}
+	return dst[:0]
+}
@@ -0,0 +1,133 @@
+package pgvector
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"io"
+	"math"
+	"slices"
+	"strings"
+)
+
+// SparseVocabulary maps tokens to sparse vector indices.
+type SparseVocabulary struct {
+	dim     int32
+	tokens  map[int32]string
+	indices map[string]int32
+}
+
+// TokenWeight is a token and its weight in a sparse vector.
+type TokenWeight struct {
+	Index  int32
+	Token  string
+	Weight float32
+}
+
+// NewSparseVocabulary creates a new SparseVocabulary where the index of each token is its position.
+// If a token appears more than once, the last position is used.
+func NewSparseVocabulary(tokens []string) (*SparseVocabulary, error) {
+	if len(tokens) > MaxSparseVectorDimensions {
+		return nil, newError(ErrTooManyDimensions, "sparsevec cannot have more than %d dimensions", MaxSparseVectorDimensions)
+	}
+
+	v := &SparseVocabulary{dim: int32(len(tokens)), tokens: make(map[int32]string, len(tokens)), indices: make(map[string]int32, len(tokens))}
+	for i, token := range tokens {
+		v.tokens[int32(i)] = token
+		v.indices[token] = int32(i)
+	}
+	return v, nil
+}
+
+// LoadSparseVocabulary loads a vocabulary with one token per line, like the vocab.txt file for BERT models.
+func LoadSparseVocabulary(r io.Reader) (*SparseVocabulary, error) {
+	var tokens []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		tokens = append(tokens, strings.TrimSuffix(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewSparseVocabulary(tokens)
+}
+
+// LoadSparseVocabularyJSON loads a vocabulary from a JSON object of tokens to indices, like {"[PAD]":0,"the":1996}.
+func LoadSparseVocabularyJSON(r io.Reader) (*SparseVocabulary, error) {
+	var indices map[string]int32
+	err := json.NewDecoder(r).Decode(&indices)
+	if err != nil {
+		return nil, err
+	}
+
+	// dimensions are the largest index plus one, but size the map by the number of tokens
+	// since the largest index is untrusted
+	dim := 0
+	tokens := make(map[int32]string, len(indices))
+	for token, index := range indices {
+		if index < 0 {
+			return nil, newError(ErrIndexOutOfBounds, "sparsevec index out of bounds")
+		}
+		if _, ok := tokens[index]; ok {
+			return nil, newError(ErrDuplicateIndex, "vocabulary indices must not contain duplicates")
+		}
+		tokens[index] = token
+		dim = max(dim, int(index)+1)
+	}
+	if dim > MaxSparseVectorDimensions {
+		return nil, newError(ErrTooManyDimensions, "sparsevec cannot have more than %d dimensions", MaxSparseVectorDimensions)
+	}
+
+	return &SparseVocabulary{dim: int32(dim), tokens: tokens, indices: indices}, nil
+}
+
+// Dimensions returns the number of dimensions for sparse vectors.
+func (v *SparseVocabulary) Dimensions() int32 {
+	return v.dim
+}
+
+// Index returns the index of a token.
+func (v *SparseVocabulary) Index(token string) (int32, bool) {
+	index, ok := v.indices[token]
+	return index, ok
+}
+
+// Token returns the token for an index.
+// Indices without a token have an empty token.
+func (v *SparseVocabulary) Token(index int32) (string, bool) {
+	if index < 0 || index >= v.dim {
+		return "", false
+	}
+	return v.tokens[index], true
+}
+
+// SparseVector creates a new SparseVector from token weights.
+// It returns an error if a token is not in the vocabulary.
+func (v *SparseVocabulary) SparseVector(weights map[string]float32) (SparseVector, error) {
+	elements := make(map[int32]float32, len(weights))
+	for token, weight := range weights {
+		index, ok := v.indices[token]
+		if !ok {
+			return SparseVector{}, newError(ErrUnknownToken, "unknown token %q", token)
+		}
+		elements[index] = weight
+	}
+	return NewSparseVectorFromMapE(elements, v.Dimensions())
+}
+
+// Explain returns the tokens and weights of a sparse vector, ordered by largest absolute weight first.
+func (v *SparseVocabulary) Explain(vec SparseVector) ([]TokenWeight, error) {
+	if vec.dim != v.Dimensions() {
+		return nil, newError(ErrDimensionMismatch, "different sparsevec dimensions %d and %d", vec.dim, v.Dimensions())
+	}
+
+	res := make([]TokenWeight, 0, len(vec.indices))
+	for i, index := range vec.indices {
+		token, _ := v.Token(index)
+		res = append(res, TokenWeight{Index: index, Token: token, Weight: vec.values[i]})
+	}
+	slices.SortStableFunc(res, func(a, b TokenWeight) int {
+		return cmp.Compare(math.Abs(float64(b.Weight)), math.Abs(float64(a.Weight)))
+	})
+	return res, nil
+}